@@ -1,9 +1,13 @@
 package gu
 
 import (
+	"context"
 	"fmt"
 	"html/template"
+	"io"
+	"sync"
 
+	"github.com/gu-io/gu/common"
 	"github.com/gu-io/gu/drivers/core"
 	"github.com/gu-io/gu/notifications"
 	"github.com/gu-io/gu/router"
@@ -21,19 +25,39 @@ type NApp struct {
 	views          []*NView
 	activeViews    []*NView
 	tree           *trees.Markup
+	dispatch       *notifications.Notifications
 	notifications  *notifications.AppEventNotification
 	router         *router.Router
 	resourceHeader []*trees.Markup
 	resourceBody   []*trees.Markup
+	resourceEvents []common.Remover
+
+	// driver contains the script markup of the javascript driver core, which is
+	// cloned into each render, keeping it's uid.
+	driver *trees.Markup
+
+	// ml guards the active views and the activation of routes, where each
+	// activation cancels the loaders of the previous one.
+	ml         sync.Mutex
+	activation int
+	cancelLoad context.CancelFunc
+	redirects  int
 }
 
-// App creates a new app structure to rendering gu components.
+// maxRedirects defines the maximum number of redirects of guards followed
+// before a route is activated, beyond which redirecting views are blocked.
+const maxRedirects = 10
+
+// App creates a new app structure to rendering gu components. Each app has it's
+// own notifications, through which the ViewUpdate of it's views and the events of
+// it's rendered markup are delivered.
 func App(title string, router *router.Router) *NApp {
 	var app NApp
 	app.title = title
 	app.uuid = NewKey()
 	app.router = router
-	app.notifications = notifications.AppNotification(app.uuid)
+	app.dispatch = notifications.New()
+	app.notifications = app.dispatch.AppNotification(app.uuid)
 
 	var head []*trees.Markup
 	head = append(head, elems.Title(elems.Text(app.title)))
@@ -42,6 +66,10 @@ func App(title string, router *router.Router) *NApp {
 
 	app.resourceHeader = head
 
+	app.driver = trees.NewMarkup("script", false)
+	trees.NewAttr("type", "text/javascript").Apply(app.driver)
+	trees.NewText("%s", core.JavascriptDriverCore).Apply(app.driver)
+
 	return &app
 }
 
@@ -59,6 +87,30 @@ func (app *NApp) Location() router.PushEvent {
 	return app.location.Location()
 }
 
+// Go moves the route of the app by delta within the history of it's Location,
+// returning false if the Location keeps no History or has no route at the
+// position.
+func (app *NApp) Go(delta int) bool {
+	app.initSanitCheck()
+
+	history, ok := app.location.(History)
+	if !ok {
+		return false
+	}
+
+	return history.Go(delta)
+}
+
+// Back moves the app to the previous route of it's Location. See Go.
+func (app *NApp) Back() bool {
+	return app.Go(-1)
+}
+
+// Forward moves the app to the next route of it's Location. See Go.
+func (app *NApp) Forward() bool {
+	return app.Go(1)
+}
+
 // InitApp sets the Location to be used by the NApp and it's views and components.
 func (app *NApp) InitApp(location Location) {
 	app.location = location
@@ -91,14 +143,45 @@ func (app *NApp) Active() bool {
 	return app.active
 }
 
+// Notifications returns the notifications of the app, drivers dispatch the
+// events of the app's rendered markup and listen for the ViewUpdate of it's
+// views through it.
+func (app *NApp) Notifications() *notifications.Notifications {
+	return app.dispatch
+}
+
+// Release removes the events of the markup last rendered by the app's views and
+// of it's assets from the app's notifications. It should be called once a app
+// is no longer used.
+func (app *NApp) Release() {
+	for _, view := range app.views {
+		view.release()
+	}
+
+	for _, remover := range app.resourceEvents {
+		remover.Remove()
+	}
+
+	app.resourceEvents = nil
+}
+
+// ActiveViews returns the views which matched the last activated route.
+func (app *NApp) ActiveViews() []*NView {
+	app.ml.Lock()
+	defer app.ml.Unlock()
+
+	return app.activeViews
+}
+
 // Mounted notifies all active views that they have been mounted.
 func (app *NApp) Mounted() {
-	for _, view := range app.activeViews {
+	for _, view := range app.ActiveViews() {
 		view.Mounted()
 	}
 }
 
-// ActivateRoute actives the views which are to be rendered.
+// ActivateRoute actives the views which are to be rendered, once the loaders of
+// the views finish. See PushViews.
 func (app *NApp) ActivateRoute(es interface{}) {
 	var pe router.PushEvent
 
@@ -114,7 +197,7 @@ func (app *NApp) ActivateRoute(es interface{}) {
 		pe = esm
 	}
 
-	app.activeViews = app.PushViews(pe)
+	app.PushViews(pe)
 }
 
 // AppJSON defines a struct which holds the giving sets of tree changes to be
@@ -132,6 +215,19 @@ type AppJSON struct {
 // RenderJSON returns the giving rendered tree of the app respective of the path
 // found as jons structure with markup content.
 func (app *NApp) RenderJSON(es interface{}) AppJSON {
+	return app.appJSON(es, (*NView).RenderJSON)
+}
+
+// HydrateJSON returns the tree of the app last rendered by Render respective of
+// the path found as json structure, so it's markup matches the rendered page by
+// uid. Views not yet rendered are rendered.
+func (app *NApp) HydrateJSON(es interface{}) AppJSON {
+	return app.appJSON(es, (*NView).HydrateJSON)
+}
+
+// appJSON returns the json structure of the app with the json of the active
+// views returned by viewJSON.
+func (app *NApp) appJSON(es interface{}, viewJSON func(*NView) ViewJSON) AppJSON {
 	if es != nil {
 		app.ActivateRoute(es)
 	}
@@ -151,23 +247,19 @@ func (app *NApp) RenderJSON(es interface{}) AppJSON {
 
 	var afterBody []ViewJSON
 
-	for _, view := range app.activeViews {
+	for _, view := range app.ActiveViews() {
 		switch view.target {
 		case HeadTarget:
-			tjson.Head = append(tjson.Head, view.RenderJSON())
+			tjson.Head = append(tjson.Head, viewJSON(view))
 		case BodyTarget:
-			tjson.Body = append(tjson.Body, view.RenderJSON())
+			tjson.Body = append(tjson.Body, viewJSON(view))
 		case AfterBodyTarget:
-			afterBody = append(afterBody, view.RenderJSON())
+			afterBody = append(afterBody, viewJSON(view))
 		}
 	}
 
 	tjson.Body = append(tjson.Body, afterBody...)
-
-	script := trees.NewMarkup("script", false)
-	trees.NewAttr("type", "text/javascript").Apply(script)
-	trees.NewText(core.JavascriptDriverCore).Apply(script)
-	tjson.BodyResources = append(tjson.BodyResources, script.TreeJSON())
+	tjson.BodyResources = append(tjson.BodyResources, app.driver.TreeJSON())
 
 	return tjson
 }
@@ -197,22 +289,25 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 
 	var last = elems.Div()
 
-	for _, view := range app.activeViews {
+	for _, view := range app.ActiveViews() {
+		// Keep the rendered markup, so the view can be patched or hydrated
+		// against the page.
+		tree := view.Render()
+		view.keepLive(tree)
+
 		switch view.target {
 		case HeadTarget:
-			view.Render().Apply(head)
+			tree.Apply(head)
 		case BodyTarget:
-			view.Render().Apply(body)
+			tree.Apply(body)
 		case AfterBodyTarget:
-			view.Render().Apply(last)
+			tree.Apply(last)
 		}
 	}
 
-	script := trees.NewMarkup("script", false)
-	trees.NewAttr("type", "text/javascript").Apply(script)
-	trees.NewText(core.JavascriptDriverCore).Apply(script)
-
-	script.Apply(last)
+	// Each render gets a copy of the script, as markup can only be added into
+	// a single parent.
+	app.driver.Clone().Apply(last)
 
 	last.ApplyChildren(body)
 
@@ -221,28 +316,274 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 	return html
 }
 
-// PushViews returns a slice of  views that match and pass the provided path.
+// RenderTo writes the html of the rendered tree of the app respective of the path
+// found directly into the writer (e.g a http.ResponseWriter), without building
+// the html string in memory.
+func (app *NApp) RenderTo(w io.Writer, es interface{}) (int64, error) {
+	return trees.SimpleElementWriter.WriteTo(w, app.Render(es))
+}
+
+// PushViews activates the views that match and pass the provided path,
+// returning them. Views and components are blocked by their guards, where a
+// guard returning a redirect navigates the app to it instead, and the loaders
+// of the views are run concurrently before they are activated, views whose
+// loaders fail are not activated. The active views are left unchanged if the
+// app is redirected, or another route is activated before the loaders finish.
+// The nested views of the active views are activated within their outlets with
+// the path left after the routes of the views.
 func (app *NApp) PushViews(event router.PushEvent) []*NView {
-	// fmt.Printf("Routing Path: %s\n", event.Rem)
-	var active []*NView
+	ctx, activation := app.beginActivation()
+
+	match := routeMatch{
+		events:  make(map[*NView]router.PushEvent),
+		outlets: make(map[*outlet]*NView),
+		blocked: make(map[*Component]bool),
+	}
+
+	if _, redirected := app.matchViews(app.views, event, false, &match); redirected {
+		return app.ActiveViews()
+	}
+
+	loaded := make(map[*NView]bool)
+	for _, view := range app.loadViews(ctx, event, match.views) {
+		loaded[view] = true
+	}
 
+	var active []*NView
 	for _, view := range app.views {
+		if loaded[view] {
+			active = append(active, view)
+		}
+	}
+
+	app.ml.Lock()
+	if activation != app.activation {
+		app.ml.Unlock()
+		return app.ActiveViews()
+	}
+
+	mounted := make(map[*NView]bool)
+	for _, view := range app.activeViews {
+		mounted[view] = true
+	}
+
+	app.activeViews = active
+	app.redirects = 0
+	app.cancelLoad()
+	app.cancelLoad = nil
+	app.ml.Unlock()
+
+	app.applyViews(app.views, event, &match, loaded, mounted)
+
+	return active
+}
+
+// routeMatch defines the views matched by a route which passed their guards,
+// with the events given to them, the nested views matched within outlets and
+// the components blocked by their guards.
+type routeMatch struct {
+	views   []*NView
+	events  map[*NView]router.PushEvent
+	outlets map[*outlet]*NView
+	blocked map[*Component]bool
+}
+
+// matchViews adds the views matching the event which pass their guards into the
+// match, with their nested views, returning them. Only the first view to match
+// is added if first is true. It returns true if a guard redirected the app.
+func (app *NApp) matchViews(views []*NView, event router.PushEvent, first bool, match *routeMatch) ([]*NView, bool) {
+	var matched []*NView
+
+	for _, view := range views {
+		if _, _, ok := view.router.Test(event.Rem); !ok {
+			continue
+		}
+
+		allow, redirect := runGuards(view.guards, event)
+		if redirect != "" && app.redirect(redirect) {
+			return nil, true
+		}
+
+		if !allow {
+			continue
+		}
+
+		for _, component := range view.components() {
+			allow, redirect := runGuards(component.guards, event)
+			if redirect != "" && app.redirect(redirect) {
+				return nil, true
+			}
+
+			match.blocked[component] = !allow
+		}
+
+		match.views = append(match.views, view)
+		match.events[view] = event
+		matched = append(matched, view)
+
+		nested := view.shift(event)
+		for _, outlet := range view.outlets {
+			views, redirected := app.matchViews(outlet.views, nested, true, match)
+			if redirected {
+				return nil, true
+			}
+
+			if len(views) != 0 {
+				match.outlets[outlet] = views[0]
+			}
+		}
+
+		if first {
+			break
+		}
+	}
+
+	return matched, false
+}
+
+// applyViews activates the views of the match which loaded with their events,
+// with their nested views within their outlets, notifying the other views that
+// they are not active. Only views which were mounted, being active before the
+// activation, are unmounted, where nested views are unmounted by their outlets.
+func (app *NApp) applyViews(views []*NView, event router.PushEvent, match *routeMatch, loaded map[*NView]bool, mounted map[*NView]bool) {
+	for _, view := range views {
+		if _, ok := match.events[view]; ok && loaded[view] {
+			for _, component := range view.components() {
+				component.blocked = match.blocked[component]
+			}
+
+			view.propagateRoute(event)
+
+			nested := view.shift(event)
+			for _, outlet := range view.outlets {
+				active := match.outlets[outlet]
+				if !loaded[active] {
+					active = nil
+				}
+
+				outlet.setActive(active)
+				app.applyViews(outlet.views, nested, match, loaded, mounted)
+			}
+
+			continue
+		}
+
 		if _, _, ok := view.router.Test(event.Rem); !ok {
 			// Notify view to appropriate proper action when view does not match.
 			view.router.Resolve(event)
 			continue
 		}
 
-		view.propagateRoute(event)
-		active = append(active, view)
+		// The view matched but was blocked by it's guards, loaders or a nested
+		// view matched before it within it's outlet.
+		view.disableView()
+
+		if mounted[view] {
+			view.Unmounted()
+		}
+	}
+}
+
+// beginActivation starts a new activation of a route, cancelling the loaders of
+// the previous activation. It returns the context of the loaders of the
+// activation and it's number.
+func (app *NApp) beginActivation() (context.Context, int) {
+	app.ml.Lock()
+	defer app.ml.Unlock()
+
+	if app.cancelLoad != nil {
+		app.cancelLoad()
 	}
 
-	return active
+	ctx, cancel := context.WithCancel(context.Background())
+
+	app.activation++
+	app.cancelLoad = cancel
+
+	return ctx, app.activation
+}
+
+// redirect navigates the app to the route, returning false if the maximum
+// number of redirects since a route was last activated is reached. Redirects
+// are counted until a activation completes, as Locations may activate the route
+// they navigate to asynchronously.
+func (app *NApp) redirect(to string) bool {
+	app.ml.Lock()
+	if app.redirects >= maxRedirects {
+		app.ml.Unlock()
+		return false
+	}
+
+	app.redirects++
+	app.ml.Unlock()
+
+	// Redirects replace the blocked route, so going back skips it.
+	app.Navigate(router.PushDirectiveEvent{To: to, Replace: true})
+	return true
+}
+
+// loadViews runs the loaders of the views concurrently, returning the views
+// whose loaders succeeded. A ViewLoadFailed is dispatched for views whose
+// loaders failed, unless the activation was cancelled.
+func (app *NApp) loadViews(ctx context.Context, event router.PushEvent, views []*NView) []*NView {
+	var wg sync.WaitGroup
+
+	for _, view := range views {
+		if len(view.loaders) == 0 {
+			view.setState(ViewReady, nil)
+			continue
+		}
+
+		view.setState(ViewPending, nil)
+
+		wg.Add(1)
+		go func(view *NView) {
+			defer wg.Done()
+			view.load(ctx, event)
+		}(view)
+	}
+
+	wg.Wait()
+
+	var loaded []*NView
+	for _, view := range views {
+		state, err := view.State()
+		if state != ViewFailed {
+			loaded = append(loaded, view)
+			continue
+		}
+
+		if ctx.Err() == nil {
+			app.dispatch.Dispatch(ViewLoadFailed{
+				App:   app,
+				View:  view,
+				Route: event,
+				Err:   err,
+			})
+		}
+	}
+
+	return loaded
+}
+
+// runGuards returns false if any of the guards blocks the route, with the
+// redirect of the first guard which returned one.
+func runGuards(guards []Guard, event router.PushEvent) (bool, string) {
+	for _, guard := range guards {
+		if allow, redirect := guard(event); !allow || redirect != "" {
+			return false, redirect
+		}
+	}
+
+	return true, ""
 }
 
 // AddAsset adds giving tree.Markup has assets to be loaded either in the head
-// or body based on the posiiton desired.
+// or body based on the posiiton desired. The events of the asset are subscribed
+// to the app's notifications.
 func (app *NApp) AddAsset(asset *trees.Markup, target ViewTarget) {
+	app.resourceEvents = append(app.resourceEvents, asset.SubscribeEvents(app.dispatch)...)
+
 	switch target {
 	case HeadTarget:
 		app.resourceHeader = append(app.resourceHeader, asset)
@@ -286,6 +627,15 @@ const (
 func (app *NApp) View(renderable interface{}, route string, target ViewTarget) *NView {
 	app.initSanitCheck()
 
+	view := app.newView(renderable, route, target, nil)
+	app.views = append(app.views, view)
+
+	return view
+}
+
+// newView returns a new view for the renderable, nested within the parent view
+// if not nil.
+func (app *NApp) newView(renderable interface{}, route string, target ViewTarget, parent *NView) *NView {
 	if route == "" {
 		route = "*"
 	}
@@ -308,19 +658,28 @@ func (app *NApp) View(renderable interface{}, route string, target ViewTarget) *
 
 	var vw NView
 	vw.root = app
+	vw.parent = parent
 	vw.target = target
 	vw.base = base
 	vw.uuid = NewKey()
 	vw.appUUID = app.uuid
 	vw.Reactive = NewReactive()
+	vw.mounted = NewSubscriptions()
+	vw.rendered = NewSubscriptions()
+	vw.updated = NewSubscriptions()
+	vw.unmounted = NewSubscriptions()
+	vw.requests = router.NewScope()
 
 	vw.router = router.NewResolver(route)
 
 	// app.driver.Update(app, &vw)
 	vw.React(func() {
-		notifications.Dispatch(ViewUpdate{
+		vw.markDirty()
+
+		// Nested views are rendered by the top view they are nested within.
+		app.dispatch.Dispatch(ViewUpdate{
 			App:  app,
-			View: &vw,
+			View: vw.top(),
 		})
 	})
 
@@ -331,8 +690,6 @@ func (app *NApp) View(renderable interface{}, route string, target ViewTarget) *
 		vw.Unmounted()
 	})
 
-	app.views = append(app.views, &vw)
-
 	return &vw
 }
 
@@ -357,6 +714,39 @@ type NView struct {
 	// location      Location
 	router router.Resolver
 
+	// live contains a copy of the last rendered markup used to patch the view.
+	live *trees.Markup
+
+	// events contains the removers of the events subscribed by the last render.
+	events []common.Remover
+
+	// requests contains the requests made by the components of the view through
+	// the router of the app, which are cancelled when the view is unmounted.
+	requests *router.Scope
+
+	// guards and loaders contains the guards deciding if routes activate the
+	// view and the loaders run before it is activated, with sml guarding the
+	// state and error of the last run of the loaders.
+	guards  []Guard
+	loaders []Loader
+	sml     sync.Mutex
+	state   ViewState
+	err     error
+
+	// parent contains the view the view is nested within, and outlets the
+	// outlets of the view it's nested views are rendered within.
+	parent  *NView
+	outlets []*outlet
+
+	// frame contains the markup of the base and components of a view with
+	// outlets, which is rendered again only when dirty, so moving between the
+	// nested views of it's outlets only renders the outlets. routeKey contains
+	// the parameters of the route the frame was rendered with.
+	rml      sync.Mutex
+	frame    *trees.Markup
+	dirty    bool
+	routeKey string
+
 	mounted   Subscriptions
 	rendered  Subscriptions
 	updated   Subscriptions
@@ -372,6 +762,14 @@ func (v *NView) UUID() string {
 	return v.uuid
 }
 
+// components returns the components of the view in rendering order.
+func (v *NView) components() []*Component {
+	var components []*Component
+	components = append(components, v.beginComponents...)
+	components = append(components, v.anyComponents...)
+	return append(components, v.lastComponents...)
+}
+
 // totalComponents returns the total component list.
 func (v *NView) totalComponents() int {
 	return len(v.beginComponents) + len(v.anyComponents) + len(v.lastComponents)
@@ -388,24 +786,127 @@ type ViewJSON struct {
 // RenderJSON returns the ViewJSON for the provided View and its current events and
 // changes.
 func (v *NView) RenderJSON() ViewJSON {
+	tree := v.Render()
+	v.keepLive(tree)
+
+	return ViewJSON{
+		AppID:  v.appUUID,
+		ViewID: v.uuid,
+		Tree:   tree.TreeJSON(),
+	}
+}
+
+// HydrateJSON returns the ViewJSON for the markup last rendered by the View,
+// which the page rendered with it is adopted against. The View is rendered if
+// it has no rendered markup.
+func (v *NView) HydrateJSON() ViewJSON {
+	if v.live == nil {
+		return v.RenderJSON()
+	}
+
 	return ViewJSON{
 		AppID:  v.appUUID,
 		ViewID: v.uuid,
-		Tree:   v.Render().TreeJSON(),
+		Tree:   v.live.TreeJSON(),
 	}
 }
 
+// ViewPatchJSON defines a struct which holds the giving sets of patches to be
+// applied to a rendered view and the events of the view.
+type ViewPatchJSON struct {
+	AppID   string            `json:"AppID"`
+	ViewID  string            `json:"ViewID"`
+	Patches []trees.Patch     `json:"Patches"`
+	Events  []trees.EventJSON `json:"Events"`
+}
+
+// PatchJSON returns the ViewPatchJSON for the provided View which contains the
+// patches needed to transform the last rendered markup into the current.
+func (v *NView) PatchJSON() ViewPatchJSON {
+	tree := v.Render()
+
+	var patches []trees.Patch
+
+	if v.live != nil {
+		tree.Reconcile(v.live)
+		patches = trees.Diff(v.live, tree)
+	} else {
+		patches = trees.Diff(nil, tree)
+	}
+
+	v.keepLive(tree)
+
+	var events []trees.EventJSON
+	v.live.EachEvent(func(event *trees.Event, _ *trees.Markup) {
+		events = append(events, event.EventJSON())
+	})
+
+	return ViewPatchJSON{
+		AppID:   v.appUUID,
+		ViewID:  v.uuid,
+		Patches: patches,
+		Events:  events,
+	}
+}
+
+// keepLive stores a copy of the rendered markup to be used for patching.
+func (v *NView) keepLive(tree *trees.Markup) {
+	v.live = tree.Clone()
+	v.live.Clean()
+}
+
 // Target returns the associated view target.
 func (v *NView) Target() ViewTarget {
 	return v.target
 }
 
-// Render returns the markup for the giving views.
+// Render returns the markup for the giving views, with the active nested views
+// of it's outlets. The markup of views with outlets is rendered again only when
+// the view or it's components changed, or the parameters of it's route.
 func (v *NView) Render() *trees.Markup {
+	if len(v.outlets) == 0 {
+		return v.renderFrame()
+	}
+
+	v.rml.Lock()
+	frame, dirty := v.frame, v.dirty
+	v.dirty = false
+	v.rml.Unlock()
+
+	if frame == nil || dirty {
+		frame = v.renderFrame()
+
+		for _, outlet := range v.outlets {
+			outlet.placed = placement{}
+		}
+	} else {
+		// Remove the markup reconciliation marked as removed from the last render.
+		frame.Clean()
+	}
+
+	for _, outlet := range v.outlets {
+		outlet.render(frame)
+	}
+
+	frame.UpdateHash()
+
+	v.rml.Lock()
+	v.frame = frame
+	v.rml.Unlock()
+
+	return frame
+}
+
+// renderFrame returns the markup of the base and components of the view.
+func (v *NView) renderFrame() *trees.Markup {
 	base := v.base.Render()
 
 	// Process the begin components and immediately add appropriately into base.
 	for _, component := range v.beginComponents {
+		if component.blocked {
+			continue
+		}
+
 		if component.Target == "" {
 			component.Render().ApplyMorphers().Apply(base)
 			continue
@@ -421,6 +922,10 @@ func (v *NView) Render() *trees.Markup {
 
 	// Process the middle components and immediately add appropriately into base.
 	for _, component := range v.anyComponents {
+		if component.blocked {
+			continue
+		}
+
 		if component.Target == "" {
 			component.Render().ApplyMorphers().Apply(base)
 			continue
@@ -436,6 +941,10 @@ func (v *NView) Render() *trees.Markup {
 
 	// Process the last components and immediately add appropriately into base.
 	for _, component := range v.lastComponents {
+		if component.blocked {
+			continue
+		}
+
 		if component.Target == "" {
 			component.Render().ApplyMorphers().Apply(base)
 			continue
@@ -452,17 +961,215 @@ func (v *NView) Render() *trees.Markup {
 	base.SwapUID(v.uuid)
 	base.UpdateHash()
 
+	v.subscribeEvents(base)
+
 	return base
 }
 
+// subscribeEvents subscribes the events of the rendered markup to the app's
+// notifications, removing the events of the previous render.
+func (v *NView) subscribeEvents(tree *trees.Markup) {
+	for _, remover := range v.events {
+		remover.Remove()
+	}
+
+	v.events = tree.SubscribeEvents(v.root.dispatch)
+}
+
+// Guard adds the guard to the guards deciding if a route activates the view,
+// which are called in the order they were added.
+func (v *NView) Guard(guard Guard) *NView {
+	v.guards = append(v.guards, guard)
+	return v
+}
+
+// Load adds the loader to the loaders run with the route before the view is
+// activated, where the view is not activated if any of them fails. The loaders
+// are run concurrently and should stop when their context is cancelled.
+func (v *NView) Load(loader Loader) *NView {
+	v.loaders = append(v.loaders, loader)
+	return v
+}
+
+// State returns the state of the loaders of the view, with the error of the
+// loader which failed.
+func (v *NView) State() (ViewState, error) {
+	v.sml.Lock()
+	defer v.sml.Unlock()
+
+	return v.state, v.err
+}
+
+// setState sets the state of the loaders of the view.
+func (v *NView) setState(state ViewState, err error) {
+	v.sml.Lock()
+	defer v.sml.Unlock()
+
+	v.state = state
+	v.err = err
+}
+
+// load runs the loaders of the view concurrently with the route, setting the
+// state of the view to failed with the error of the first loader which fails.
+func (v *NView) load(ctx context.Context, event router.PushEvent) {
+	var rt *router.Router
+	if v.root.router != nil {
+		rt = v.root.router.WithScope(v.requests)
+	}
+
+	errs := make(chan error, len(v.loaders))
+
+	for _, loader := range v.loaders {
+		go func(loader Loader) {
+			defer func() {
+				if failure := recover(); failure != nil {
+					errs <- fmt.Errorf("Loader panicked: %v", failure)
+				}
+			}()
+
+			errs <- loader(ctx, rt, event)
+		}(loader)
+	}
+
+	var failed error
+	for range v.loaders {
+		if err := <-errs; err != nil && failed == nil {
+			failed = err
+		}
+	}
+
+	if failed != nil {
+		v.setState(ViewFailed, failed)
+		return
+	}
+
+	v.setState(ViewReady, nil)
+}
+
 // propagateRoute supplies the needed route into the provided
 func (v *NView) propagateRoute(pe router.PushEvent) {
 	v.router.Resolve(pe)
+
+	if len(v.outlets) == 0 {
+		return
+	}
+
+	// Components routed by the view depend on the whole route.
+	params, _, _ := v.router.Test(pe.Rem)
+	key := fmt.Sprintf("%v", params)
+	for _, component := range v.components() {
+		if component.routed {
+			key = pe.Rem
+			break
+		}
+	}
+
+	v.rml.Lock()
+	defer v.rml.Unlock()
+
+	if key != v.routeKey {
+		v.routeKey = key
+		v.dirty = true
+	}
+}
+
+// shift returns the event given to the nested views of the view, with the path
+// left after the route of the view and the parameters of the route.
+func (v *NView) shift(pe router.PushEvent) router.PushEvent {
+	params, rem, _ := v.router.Test(pe.Rem)
+	if params == nil {
+		params = make(map[string]string)
+	}
+
+	for key, val := range pe.Params {
+		params[key] = val
+	}
+
+	return router.PushEvent{
+		Rem:    rem,
+		Params: params,
+		Hash:   pe.Hash,
+		Host:   pe.Host,
+		Path:   pe.Path,
+		From:   pe.Rem,
+		To:     rem,
+		Query:  pe.Query,
+	}
+}
+
+// markDirty marks the markup of the view to be rendered again.
+func (v *NView) markDirty() {
+	v.rml.Lock()
+	defer v.rml.Unlock()
+
+	v.dirty = true
+}
+
+// top returns the top view the view is nested within, or the view itself.
+func (v *NView) top() *NView {
+	view := v
+	for view.parent != nil {
+		view = view.parent
+	}
+
+	return view
+}
+
+// Outlet declares a named outlet of the view, where the active nested view of the
+// outlet is rendered into the first markup matching the selector, or the root of
+// the view's markup if the selector is empty.
+func (v *NView) Outlet(name string, selector string) *NView {
+	v.outlets = append(v.outlets, &outlet{
+		name:     name,
+		selector: selector,
+	})
+
+	return v
+}
+
+// View adds a nested view into the named outlet of the view, which is active
+// when it's route matches the path left after the route of the view, which
+// should end with "/*". The first nested view of a outlet whose route matches
+// and passes it's guards and loaders is rendered within the outlet, so moving
+// between the nested views of a outlet only renders the outlet.
+func (v *NView) View(renderable interface{}, route string, outlet string) *NView {
+	for _, item := range v.outlets {
+		if item.name != outlet {
+			continue
+		}
+
+		view := v.root.newView(renderable, route, v.target, v)
+		item.views = append(item.views, view)
+
+		return view
+	}
+
+	panic(fmt.Sprintf("Outlet %q not declared by view", outlet))
+}
+
+// Outlets returns the active nested views of the outlets of the view by the name
+// of the outlets.
+func (v *NView) Outlets() map[string]*NView {
+	active := make(map[string]*NView)
+	for _, outlet := range v.outlets {
+		if view := outlet.getActive(); view != nil {
+			active[outlet.name] = view
+		}
+	}
+
+	return active
 }
 
-// Unmounted publishes changes notifications that the view is unmounted.
+// Unmounted publishes changes notifications that the view is unmounted,
+// cancelling the in-flight requests made through the router of it's services,
+// along with the active nested views of it's outlets.
 func (v *NView) Unmounted() {
+	v.requests.Cancel()
 	v.unmounted.Publish()
+
+	for _, outlet := range v.outlets {
+		outlet.setActive(nil)
+	}
 }
 
 // Updated publishes changes notifications that the view is updated.
@@ -475,9 +1182,16 @@ func (v *NView) Rendered() {
 	v.rendered.Publish()
 }
 
-// Mounted publishes changes notifications that the view is mounted.
+// Mounted publishes changes notifications that the view is mounted, along with
+// the active nested views of it's outlets.
 func (v *NView) Mounted() {
 	v.mounted.Publish()
+
+	for _, outlet := range v.outlets {
+		if view := outlet.getActive(); view != nil {
+			view.Mounted()
+		}
+	}
 }
 
 // RenderingOrder defines a type used to define the order which rendering is to be done for a resource.
@@ -497,20 +1211,27 @@ const (
 // Services return s a Service instance which contains fields used by the
 // Components of a view to gain access to the specific functionality of it's app root.
 func (v *NView) Services() Services {
+	var rt *router.Router
+	if v.root.router != nil {
+		rt = v.root.router.WithScope(v.requests)
+	}
+
 	return Services{
-		AppUUID:   v.appUUID,
-		Location:  v.root,
-		ViewRoute: v.router,
-		Router:    v.root.router,
-		Mounted:   v.mounted,
-		Unmounted: v.unmounted,
-		Updated:   v.updated,
-		Rendered:  v.rendered,
+		AppUUID:       v.appUUID,
+		Location:      v.root,
+		ViewRoute:     v.router,
+		Router:        rt,
+		Routes:        router.DefaultRoutes(),
+		Notifications: v.root.dispatch,
+		Mounted:       v.mounted,
+		Unmounted:     v.unmounted,
+		Updated:       v.updated,
+		Rendered:      v.rendered,
 	}
 }
 
-// Component adds the provided component into the selected view.
-func (v *NView) Component(renderable interface{}, order RenderingOrder, route string, target string) {
+// Component adds the provided component into the selected view, returning it.
+func (v *NView) Component(renderable interface{}, order RenderingOrder, route string, target string) *Component {
 	var base Renderable
 
 	switch rnb := renderable.(type) {
@@ -533,6 +1254,7 @@ func (v *NView) Component(renderable interface{}, order RenderingOrder, route st
 	c.Rendering = base
 	c.Reactive = NewReactive()
 	c.Router = router.NewResolver(route)
+	c.routed = route != ""
 
 	// if the renderable can push reactions then listen.
 	if rr, ok := base.(Reactor); ok {
@@ -557,6 +1279,8 @@ func (v *NView) Component(renderable interface{}, order RenderingOrder, route st
 			v.anyComponents = append(v.anyComponents, &c)
 		}
 	}
+
+	return &c
 }
 
 // Component defines a struct which
@@ -569,6 +1293,14 @@ type Component struct {
 	Router    router.Resolver
 
 	live *trees.Markup
+
+	// guards contains the guards deciding if a route renders the component,
+	// with blocked set when a guard blocked the active route.
+	guards  []Guard
+	blocked bool
+
+	// routed is true if the component has a route.
+	routed bool
 }
 
 // UUID returns the identification for the giving component.
@@ -576,6 +1308,14 @@ func (c Component) UUID() string {
 	return c.uuid
 }
 
+// Guard adds the guard to the guards deciding if a route activating the view of
+// the component renders it, which are called in the order they were added. A
+// redirect returned by a guard navigates the app to it.
+func (c *Component) Guard(guard Guard) *Component {
+	c.guards = append(c.guards, guard)
+	return c
+}
+
 // Render returns the markup corresponding to the internal Renderable.
 func (c *Component) Render() *trees.Markup {
 	newTree := c.Rendering.Render()
@@ -598,6 +1338,98 @@ func (c *Component) Render() *trees.Markup {
 	return c.live
 }
 
+// release removes the events of the markup last rendered by the view and it's
+// nested views.
+func (v *NView) release() {
+	v.requests.Cancel()
+
+	for _, remover := range v.events {
+		remover.Remove()
+	}
+
+	v.events = nil
+
+	for _, outlet := range v.outlets {
+		for _, view := range outlet.views {
+			view.release()
+		}
+	}
+}
+
+// outlet defines a named place within the markup of a view, which renders the
+// active nested view of the outlet.
+type outlet struct {
+	name     string
+	selector string
+	views    []*NView
+
+	ml     sync.Mutex
+	active *NView
+
+	// placed contains the markup of the nested view added into the markup
+	// of the view by the last render, which is replaced on the next.
+	placed placement
+}
+
+// placement defines markup added into a target markup.
+type placement struct {
+	target *trees.Markup
+	markup *trees.Markup
+}
+
+// getActive returns the active nested view of the outlet.
+func (o *outlet) getActive() *NView {
+	o.ml.Lock()
+	defer o.ml.Unlock()
+
+	return o.active
+}
+
+// setActive sets the active nested view of the outlet, unmounting the previous
+// one.
+func (o *outlet) setActive(view *NView) {
+	o.ml.Lock()
+	previous := o.active
+	o.active = view
+	o.ml.Unlock()
+
+	if previous != nil && previous != view {
+		previous.Unmounted()
+	}
+}
+
+// render replaces the markup of the outlet within the frame with the markup of
+// the active nested view.
+func (o *outlet) render(frame *trees.Markup) {
+	if o.placed.target != nil {
+		o.placed.target.RemoveChild(o.placed.markup)
+	}
+
+	o.placed = placement{}
+
+	view := o.getActive()
+	if view == nil {
+		return
+	}
+
+	// The markup of a view has a single parent, so it's only rendered into the
+	// first markup matching the selector.
+	target := frame
+	if o.selector != "" {
+		target = trees.Query.Query(frame, o.selector)
+	}
+
+	if target == nil {
+		return
+	}
+
+	render := view.Render()
+	target.AddChild(render)
+	target.UpdateHash()
+
+	o.placed = placement{target: target, markup: render}
+}
+
 // Disabled returns true/false if the giving view is disabled.
 func (v *NView) Disabled() bool {
 	return v.active
@@ -0,0 +1,73 @@
+package gu_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+)
+
+var success = "✓"
+var failed = "✗"
+
+type page struct{}
+
+func (page) Render() *trees.Markup {
+	return elems.Div(
+		trees.NewAttr("class", "page"),
+		elems.Span(elems.Text("title")),
+		elems.Button(trees.NewAttr("class", "add"), elems.Text("add")),
+	)
+}
+
+var uidMatch = regexp.MustCompile(`uid="([^"]+)"`)
+
+func TestAppHydrateCommand(t *testing.T) {
+	app := gu.App("Hydrate", nil)
+	app.View(page{}, "*", gu.BodyTarget)
+
+	var pages []*trees.Markup
+	for index := 0; index < 2; index++ {
+		pages = append(pages, app.Render("/"))
+	}
+
+	command := gu.AppHydrateCommand(app, nil)
+
+	if command.Command != "HydrateApp" || len(command.App.Body) != 1 || len(command.App.BodyResources) == 0 {
+		t.Fatalf("\t%s\t Should have returned HydrateApp command with views and resources: %+v", failed, command)
+	}
+	t.Logf("\t%s\t Should have returned HydrateApp command with views and resources", success)
+
+	driver := command.App.BodyResources[len(command.App.BodyResources)-1]
+
+	for _, html := range pages {
+		script := trees.Query.Query(html, "script")
+		body := trees.Query.Query(html, "body")
+
+		if script == nil || script.UID() != driver.TreeID {
+			t.Fatalf("\t%s\t Should have rendered driver script with uid of HydrateApp command: %q", failed, driver.TreeID)
+		}
+
+		if !strings.Contains(script.IDSelector(true), body.UID()) {
+			t.Fatalf("\t%s\t Should have rendered driver script within body of each page: %q", failed, script.IDSelector(true))
+		}
+	}
+	t.Logf("\t%s\t Should have rendered driver script with uid of HydrateApp command within each page", success)
+
+	html := pages[1].HTML()
+	uids := uidMatch.FindAllStringSubmatch(command.App.Body[0].Tree.Markup, -1)
+
+	if len(uids) < 3 {
+		t.Fatalf("\t%s\t Should have returned nested markup of views: %q", failed, command.App.Body[0].Tree.Markup)
+	}
+
+	for _, uid := range uids {
+		if !strings.Contains(html, uid[0]) {
+			t.Fatalf("\t%s\t Should have returned markup of views with uids of rendered page: %q", failed, uid[1])
+		}
+	}
+	t.Logf("\t%s\t Should have returned markup of views with uids of rendered page", success)
+}
@@ -5,8 +5,11 @@ package assets
 import (
 	"bytes"
 	"compress/gzip"
+	"encoding/json"
 	"errors"
 	"io"
+	"runtime"
+	"sort"
 	"sync"
 	"text/template"
 
@@ -39,6 +42,15 @@ type WriteDirective struct {
 	OriginAbsPath string
 	Writer        io.WriterTo
 	Static        *StaticDirective
+
+	// Hash contains the content hash of the directive, which is set by
+	// Webpack.Compile if not set by it's packer.
+	Hash string
+
+	// Fingerprint contains the path of the directive with it's content hash,
+	// eg. "css/main.5d41402abc4b.css", which is set by Webpack.Compile if not
+	// set by it's packer.
+	Fingerprint string
 }
 
 // Read will copy directives writer into a content buffer and returns the giving string
@@ -66,6 +78,47 @@ func (directive WriteDirective) Read() (string, error) {
 	return buffer.String(), nil
 }
 
+// contentWriter defines a io.WriterTo which writes the same content on every
+// call, so directives read for their content hash can be read again.
+type contentWriter []byte
+
+// WriteTo writes the content into the writer.
+func (c contentWriter) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(c)
+	return int64(n), err
+}
+
+// Manifest defines a map of the paths of assets to their fingerprinted paths.
+type Manifest map[string]string
+
+// Fingerprint returns the fingerprinted path of the asset at path.
+func (m Manifest) Fingerprint(path string) (string, bool) {
+	fingerprint, ok := m[path]
+	return fingerprint, ok
+}
+
+// Path returns the path of the asset with the fingerprinted path.
+func (m Manifest) Path(fingerprint string) (string, bool) {
+	for path, item := range m {
+		if item == fingerprint {
+			return path, true
+		}
+	}
+
+	return "", false
+}
+
+// WriteTo writes the manifest as json into the writer.
+func (m Manifest) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
 // Packer exposes a interface which exposes methods for validating the type of files
 // it supports and a method to appropriately pack the FileStatments as desired
 // into the given endpoint directory.
@@ -73,19 +126,60 @@ type Packer interface {
 	Pack(files []FileStatement, dir DirStatement) ([]WriteDirective, error)
 }
 
+// FilePacker exposes a interface for packers which pack each file on it's own,
+// which allows the Webpack to pack files in parallel and repack only the files
+// which changed.
+type FilePacker interface {
+	Packer
+	PackFile(file FileStatement, dir DirStatement) ([]WriteDirective, error)
+}
+
+// DependencyPacker exposes a interface for packers whose output depends on the
+// content of files other than those packed, such as those imported by a file,
+// which allows the Webpack to repack files when their dependencies change.
+type DependencyPacker interface {
+	Packer
+
+	// Dependencies returns the absolute paths of the files the output of the
+	// file depends on, including their own dependencies.
+	Dependencies(file FileStatement, dir DirStatement) ([]string, error)
+}
+
+// Option defines a function type used to configure a Webpack.
+type Option func(*Webpack)
+
+// Workers sets the maximum number of packers run in parallel by a build, which
+// defaults to the number of CPUs.
+func Workers(max int) Option {
+	return func(w *Webpack) {
+		w.workers = max
+	}
+}
+
 // Webpack defines the core structure for handling bundling of different assets
 // using registered packers.
 type Webpack struct {
 	defaultPacker Packer
 	packers       map[string]Packer
+	manifest      Manifest
+	workers       int
+	cache         *buildCache
 }
 
 // New returns a new instance of the Webpack.
-func New(defaultPacker Packer) *Webpack {
-	return &Webpack{
+func New(defaultPacker Packer, options ...Option) *Webpack {
+	w := &Webpack{
 		defaultPacker: defaultPacker,
 		packers:       make(map[string]Packer, 0),
+		workers:       runtime.NumCPU(),
+		cache:         newBuildCache(),
 	}
+
+	for _, option := range options {
+		option(w)
+	}
+
+	return w
 }
 
 // Register adds the Packer to manage the building of giving exensions.
@@ -95,17 +189,26 @@ func (w *Webpack) Register(ext string, packer Packer) {
 
 // Build runs through the directory pull all files and runs them through the
 // packers to service each files by extension and returns a slice of all
-// WriteDirective for final processing.
+// WriteDirective for final processing. Packers are run in parallel, and the
+// output of packers whose files, dependencies and configuration, taken from the
+// value of the packer, are unchanged since the last build is reused from it, see
+// FilePacker and DependencyPacker.
 func (w *Webpack) Build(dir string, doGoSources bool) (map[string][]WriteDirective, map[string][]WriteDirective, error) {
 	statement, err := GetDirStatement(dir, doGoSources)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	wd := make(map[string][]WriteDirective, 0)
-	staticWd := make(map[string][]WriteDirective, 0)
+	var exts []string
+	for ext := range statement.FilesByExt {
+		exts = append(exts, ext)
+	}
+
+	sort.Strings(exts)
+
+	var jobs []*buildJob
 
-	for ext, fileStatement := range statement.FilesByExt {
+	for _, ext := range exts {
 		packer, ok := w.packers[ext]
 		if !ok && w.defaultPacker == nil {
 			continue
@@ -117,48 +220,100 @@ func (w *Webpack) Build(dir string, doGoSources bool) (map[string][]WriteDirecti
 			continue
 		}
 
-		var derr error
-		var directives []WriteDirective
+		if !ok {
+			packer = w.defaultPacker
+		}
+
+		jobs = append(jobs, newBuildJobs(packer, statement.FilesByExt[ext])...)
+	}
+
+	w.run(jobs, statement)
 
-		if !ok && w.defaultPacker != nil {
-			directives, derr = w.defaultPacker.Pack(fileStatement, statement)
-		} else {
-			directives, derr = packer.Pack(fileStatement, statement)
+	wd := make(map[string][]WriteDirective, 0)
+	staticWd := make(map[string][]WriteDirective, 0)
+
+	for _, job := range jobs {
+		if job.err != nil {
+			return wd, staticWd, job.err
 		}
 
-		if derr != nil {
-			return wd, staticWd, derr
+		for _, directive := range job.directives {
+			fileExt := getExtension(directive.OriginPath)
+
+			if directive.Static != nil {
+				staticWd[fileExt] = append(staticWd[fileExt], directive)
+				continue
+			}
+
+			wd[fileExt] = append(wd[fileExt], directive)
 		}
+	}
 
-		func(drs []WriteDirective) {
-			for _, directive := range drs {
-				fileExt := getExtension(directive.OriginPath)
+	w.cache.prune(jobs)
 
-				// fmt.Printf("- Packing %q under %q\n", directive.OriginAbsPath, fileExt)
+	return wd, staticWd, nil
+}
 
-				if directive.Static != nil {
-					staticWd[fileExt] = append(staticWd[fileExt], directive)
-					continue
-				}
+// run packs the jobs with at most the number of workers of the Webpack running
+// at once.
+func (w *Webpack) run(jobs []*buildJob, statement DirStatement) {
+	queue := make(chan *buildJob)
+	hashes := newFileHashes()
 
-				wd[fileExt] = append(wd[fileExt], directive)
+	workers := w.workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	if workers > len(jobs) {
+		workers = len(jobs)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for job := range queue {
+				job.pack(w.cache, hashes, statement)
 			}
-		}(directives)
+		}()
 	}
 
-	return wd, staticWd, nil
+	for _, job := range jobs {
+		queue <- job
+	}
+
+	close(queue)
+	wg.Wait()
+}
+
+// Manifest returns the Manifest of the assets compiled by the last call to
+// Compile, which can be written as json with it's WriteTo method.
+func (w *Webpack) Manifest() Manifest {
+	return w.manifest
 }
 
 // Compile returns a io.WriterTo which contains a complete source of all assets
 // generated and stored inside a io.WriteTo which will contain the go source excluding
 // the package declaration so has to allow you write the contents into the package
-// you wish.
+// you wish. The content hash of each asset is used to serve it by it's fingerprinted
+// path, with the paths recorded in the Manifest of the Webpack.
 func (w *Webpack) Compile(dir string, doGoSources bool) (io.WriterTo, map[string][]WriteDirective, error) {
 	directives, statics, err := w.Build(dir, doGoSources)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	manifest, err := fingerprint(directives)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w.manifest = manifest
+
 	content := gen.Block(
 		gen.SourceTextWith(
 			string(data.Must("scaffolds/pack-bundle-src.gen")),
@@ -175,3 +330,33 @@ func (w *Webpack) Compile(dir string, doGoSources bool) (io.WriterTo, map[string
 
 	return content, statics, nil
 }
+
+// fingerprint sets the content hash and fingerprinted path of the directives
+// not set by their packers, and returns the Manifest of their paths.
+func fingerprint(directives map[string][]WriteDirective) (Manifest, error) {
+	manifest := make(Manifest)
+
+	for _, drs := range directives {
+		for index, directive := range drs {
+			var bu bytes.Buffer
+			if _, err := directive.Writer.WriteTo(&bu); err != nil && err != io.EOF {
+				return nil, err
+			}
+
+			directive.Writer = contentWriter(bu.Bytes())
+
+			if directive.Hash == "" {
+				directive.Hash = ContentHash(bu.Bytes())
+			}
+
+			if directive.Fingerprint == "" {
+				directive.Fingerprint = HashedPath(directive.OriginPath, directive.Hash)
+			}
+
+			manifest[directive.OriginPath] = directive.Fingerprint
+			drs[index] = directive
+		}
+	}
+
+	return manifest, nil
+}
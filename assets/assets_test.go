@@ -0,0 +1,259 @@
+package assets_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/assets"
+	"github.com/gu-io/gu/assets/packers"
+	"github.com/influx6/faux/tests"
+)
+
+func TestCompileFingerprints(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gu-assets")
+	if err != nil {
+		tests.Failed("Should have successfully created directory: %+q", err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	if err := os.MkdirAll(filepath.Join(dir, "css"), 0700); err != nil {
+		tests.Failed("Should have successfully created css directory: %+q", err)
+	}
+
+	content := []byte("body{color:red}")
+	if err := ioutil.WriteFile(filepath.Join(dir, "css", "main.css"), content, 0600); err != nil {
+		tests.Failed("Should have successfully created css file: %+q", err)
+	}
+
+	webpack := assets.New(packers.RawPacker{})
+
+	source, _, err := webpack.Compile(dir, false)
+	if err != nil {
+		tests.Failed("Should have successfully compiled assets: %+q", err)
+	}
+	tests.Passed("Should have successfully compiled assets")
+
+	fingerprint := assets.Fingerprint("css/main.css", content)
+	if fingerprint != "css/main."+assets.ContentHash(content)+".css" {
+		tests.Failed("Should have successfully added content hash to path: %q", fingerprint)
+	}
+	tests.Passed("Should have successfully added content hash to path")
+
+	manifest := webpack.Manifest()
+
+	if path, ok := manifest.Fingerprint("css/main.css"); !ok || path != fingerprint {
+		tests.Failed("Should have successfully recorded fingerprinted path in manifest: %q", path)
+	}
+	tests.Passed("Should have successfully recorded fingerprinted path in manifest")
+
+	if path, ok := manifest.Path(fingerprint); !ok || path != "css/main.css" {
+		tests.Failed("Should have successfully found path of fingerprinted path in manifest: %q", path)
+	}
+	tests.Passed("Should have successfully found path of fingerprinted path in manifest")
+
+	var manifestJSON bytes.Buffer
+	if _, err := manifest.WriteTo(&manifestJSON); err != nil {
+		tests.Failed("Should have successfully written manifest: %+q", err)
+	}
+
+	var decoded map[string]string
+	if err := json.Unmarshal(manifestJSON.Bytes(), &decoded); err != nil || decoded["css/main.css"] != fingerprint {
+		tests.Failed("Should have successfully written manifest as json: %s", manifestJSON.String())
+	}
+	tests.Passed("Should have successfully written manifest as json")
+
+	var bu bytes.Buffer
+	bu.WriteString("package bundle\n")
+
+	if _, err := source.WriteTo(&bu); err != nil {
+		tests.Failed("Should have successfully written source: %+q", err)
+	}
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "bundle.go", bu.Bytes(), 0)
+	if err != nil {
+		tests.Failed("Should have successfully generated valid go source: %+q", err)
+	}
+
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := config.Check("bundle", fset, []*ast.File{file}, nil); err != nil {
+		tests.Failed("Should have successfully generated valid go source: %+q", err)
+	}
+	tests.Passed("Should have successfully generated valid go source")
+
+	if !strings.Contains(bu.String(), `"`+fingerprint+`": "css/main.css"`) {
+		tests.Failed("Should have successfully generated lookup of fingerprinted path")
+	}
+	tests.Passed("Should have successfully generated lookup of fingerprinted path")
+}
+
+// bundleTest contains the tests run against a generated bundle, checking it's
+// FileSystem and the gzip, range and conditional requests of it's Handler.
+const bundleTest = `package bundle
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+)
+
+const content = "body{color:red}"
+
+func serve(path string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", path, nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+func TestFileSystem(t *testing.T) {
+	if err := fstest.TestFS(FS, "css/main.css", "js/app.js"); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := HTTPFS.Open("/css/main.css")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil || string(data) != content {
+		t.Fatalf("Should have read file through HTTPFileSystem: %q %v", data, err)
+	}
+}
+
+func TestHandlerGzip(t *testing.T) {
+	res := serve("/css/main.css", map[string]string{"Accept-Encoding": "gzip"})
+	if res.Code != http.StatusOK || res.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("Should have served gzipped file: %d %q", res.Code, res.Header().Get("Content-Encoding"))
+	}
+
+	reader, err := gzip.NewReader(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data, err := ioutil.ReadAll(reader); err != nil || string(data) != content {
+		t.Fatalf("Should have served gzipped content of file: %q %v", data, err)
+	}
+
+	if res = serve("/css/main.css", nil); res.Header().Get("Content-Encoding") != "" || res.Body.String() != content {
+		t.Fatalf("Should have served content of file without gzip: %q", res.Body.String())
+	}
+}
+
+func TestHandlerRange(t *testing.T) {
+	res := serve("/css/main.css", map[string]string{"Accept-Encoding": "gzip", "Range": "bytes=0-3"})
+	if res.Code != http.StatusPartialContent || res.Header().Get("Content-Encoding") != "" || res.Body.String() != content[:4] {
+		t.Fatalf("Should have served range of content of file: %d %q", res.Code, res.Body.String())
+	}
+}
+
+func TestHandlerConditional(t *testing.T) {
+	for _, headers := range []map[string]string{nil, {"Accept-Encoding": "gzip"}} {
+		etag := serve("/css/main.css", headers).Header().Get("ETag")
+		if etag == "" {
+			t.Fatal("Should have served ETag of file")
+		}
+
+		conditional := map[string]string{"If-None-Match": etag}
+		for name, value := range headers {
+			conditional[name] = value
+		}
+
+		if res := serve("/css/main.css", conditional); res.Code != http.StatusNotModified || res.Body.Len() != 0 {
+			t.Fatalf("Should have served not modified for ETag %s: %d", etag, res.Code)
+		}
+	}
+
+	fingerprint, _ := FingerprintFor("css/main.css")
+
+	res := serve("/"+fingerprint, map[string]string{"Accept-Encoding": "gzip"})
+	if res.Code != http.StatusOK || !bytes.Contains([]byte(res.Header().Get("Cache-Control")), []byte("immutable")) {
+		t.Fatalf("Should have served fingerprinted file as immutable: %d %q", res.Code, res.Header().Get("Cache-Control"))
+	}
+}
+`
+
+func TestCompileBundle(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("Should have go tool to test generated bundle")
+	}
+
+	dir, err := ioutil.TempDir("", "gu-assets")
+	if err != nil {
+		tests.Failed("Should have successfully created directory: %+q", err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"css/main.css": "body{color:red}",
+		"js/app.js":    "console.log(1)",
+	}
+
+	for path, content := range files {
+		path = filepath.Join(dir, "src", filepath.FromSlash(path))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			tests.Failed("Should have successfully created asset directory: %+q", err)
+		}
+
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			tests.Failed("Should have successfully created asset file: %+q", err)
+		}
+	}
+
+	source, _, err := assets.New(packers.RawPacker{}).Compile(filepath.Join(dir, "src"), false)
+	if err != nil {
+		tests.Failed("Should have successfully compiled assets: %+q", err)
+	}
+
+	var bu bytes.Buffer
+	bu.WriteString("package bundle\n")
+
+	if _, err := source.WriteTo(&bu); err != nil {
+		tests.Failed("Should have successfully written source: %+q", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "bundle.go"), bu.Bytes(), 0600); err != nil {
+		tests.Failed("Should have successfully written bundle: %+q", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "bundle_test.go"), []byte(bundleTest), 0600); err != nil {
+		tests.Failed("Should have successfully written bundle tests: %+q", err)
+	}
+
+	cmd := exec.Command(goTool, "test", "bundle.go", "bundle_test.go")
+	cmd.Dir = dir
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		tests.Failed("Should have successfully passed tests of generated bundle: %+q\n%s", err, output)
+	}
+	tests.Passed("Should have successfully passed tests of generated bundle")
+}
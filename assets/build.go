@@ -0,0 +1,209 @@
+// +build !js
+
+package assets
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+)
+
+//===============================================================================
+
+// buildJob defines a set of files packed together by a packer during a build,
+// which is all files of a extension unless the packer is a FilePacker.
+type buildJob struct {
+	packer     Packer
+	files      []FileStatement
+	key        string
+	directives []WriteDirective
+	err        error
+}
+
+// newBuildJobs returns the jobs which pack the files with the packer.
+func newBuildJobs(packer Packer, files []FileStatement) []*buildJob {
+	if _, ok := packer.(FilePacker); !ok {
+		return []*buildJob{{packer: packer, files: files}}
+	}
+
+	jobs := make([]*buildJob, len(files))
+	for index, file := range files {
+		jobs[index] = &buildJob{packer: packer, files: []FileStatement{file}}
+	}
+
+	return jobs
+}
+
+// pack sets the directives of the job from the cache if found by the key of the
+// job, else packs the files of the job and adds the directives to the cache.
+func (job *buildJob) pack(cache *buildCache, hashes *fileHashes, dir DirStatement) {
+	job.key, job.err = job.cacheKey(hashes, dir)
+	if job.err != nil {
+		return
+	}
+
+	if directives, ok := cache.get(job.key); ok {
+		job.directives = directives
+		return
+	}
+
+	var directives []WriteDirective
+
+	if packer, ok := job.packer.(FilePacker); ok && len(job.files) == 1 {
+		directives, job.err = packer.PackFile(job.files[0], dir)
+	} else {
+		directives, job.err = job.packer.Pack(job.files, dir)
+	}
+
+	if job.err != nil {
+		return
+	}
+
+	// The content of directives are kept, so they can be written again when
+	// reused by later builds.
+	for index, directive := range directives {
+		var bu bytes.Buffer
+		if _, err := directive.Writer.WriteTo(&bu); err != nil && err != io.EOF {
+			job.err = err
+			return
+		}
+
+		directives[index].Writer = contentWriter(bu.Bytes())
+	}
+
+	job.directives = directives
+	cache.put(job.key, directives)
+}
+
+// cacheKey returns the key of the output of the job, which is the hash of the
+// configuration of it's packer and the content of it's files and their
+// dependencies.
+func (job *buildJob) cacheKey(hashes *fileHashes, dir DirStatement) (string, error) {
+	key := sha256.New()
+	fmt.Fprintf(key, "%T %#v\n%s\n", job.packer, job.packer, dir.DirRoot)
+
+	depender, hasDependencies := job.packer.(DependencyPacker)
+
+	for _, file := range job.files {
+		hash, err := hashes.get(file.AbsPath)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(key, "%s %s\n", file.Path, hash)
+
+		if !hasDependencies {
+			continue
+		}
+
+		dependencies, err := depender.Dependencies(file, dir)
+		if err != nil {
+			return "", err
+		}
+
+		for _, dependency := range dependencies {
+			// Missing dependencies are recorded as such, so the file is repacked
+			// once they are created.
+			hash, err := hashes.get(dependency)
+			if err != nil {
+				hash = "missing"
+			}
+
+			fmt.Fprintf(key, "- %s %s\n", dependency, hash)
+		}
+	}
+
+	return hex.EncodeToString(key.Sum(nil)), nil
+}
+
+//===============================================================================
+
+// buildCache defines the cache of the directives of build jobs by their keys.
+type buildCache struct {
+	ml      sync.RWMutex
+	entries map[string][]WriteDirective
+}
+
+// newBuildCache returns a new instance of the buildCache.
+func newBuildCache() *buildCache {
+	return &buildCache{entries: make(map[string][]WriteDirective)}
+}
+
+// get returns a copy of the directives cached with the key.
+func (c *buildCache) get(key string) ([]WriteDirective, bool) {
+	c.ml.RLock()
+	defer c.ml.RUnlock()
+
+	directives, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	return append([]WriteDirective(nil), directives...), true
+}
+
+// put caches a copy of the directives with the key.
+func (c *buildCache) put(key string, directives []WriteDirective) {
+	c.ml.Lock()
+	defer c.ml.Unlock()
+
+	c.entries[key] = append([]WriteDirective(nil), directives...)
+}
+
+// prune removes the directives of all keys except those of the jobs.
+func (c *buildCache) prune(jobs []*buildJob) {
+	c.ml.Lock()
+	defer c.ml.Unlock()
+
+	entries := make(map[string][]WriteDirective, len(jobs))
+	for _, job := range jobs {
+		if directives, ok := c.entries[job.key]; ok {
+			entries[job.key] = directives
+		}
+	}
+
+	c.entries = entries
+}
+
+//===============================================================================
+
+// fileHashes defines the content hashes of files read during a build, so files
+// shared as dependencies are read once.
+type fileHashes struct {
+	ml     sync.Mutex
+	hashes map[string]string
+}
+
+// newFileHashes returns a new instance of the fileHashes.
+func newFileHashes() *fileHashes {
+	return &fileHashes{hashes: make(map[string]string)}
+}
+
+// get returns the sha256 hash of the content of the file at path.
+func (f *fileHashes) get(path string) (string, error) {
+	f.ml.Lock()
+	hash, ok := f.hashes[path]
+	f.ml.Unlock()
+
+	if ok {
+		return hash, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	hash = hex.EncodeToString(sum[:])
+
+	f.ml.Lock()
+	f.hashes[path] = hash
+	f.ml.Unlock()
+
+	return hash, nil
+}
@@ -0,0 +1,210 @@
+package assets_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/assets"
+	"github.com/influx6/faux/tests"
+)
+
+// packCounter records the files packed by a packer.
+type packCounter struct {
+	ml      sync.Mutex
+	packed  []string
+	running int
+	most    int
+}
+
+func (c *packCounter) start(path string) {
+	c.ml.Lock()
+	defer c.ml.Unlock()
+
+	c.packed = append(c.packed, path)
+	c.running++
+
+	if c.running > c.most {
+		c.most = c.running
+	}
+}
+
+func (c *packCounter) done() {
+	c.ml.Lock()
+	defer c.ml.Unlock()
+
+	c.running--
+}
+
+func (c *packCounter) reset() []string {
+	c.ml.Lock()
+	defer c.ml.Unlock()
+
+	packed := c.packed
+	c.packed = nil
+	return packed
+}
+
+// filePacker packs each file as it is, with the dependencies of files by their
+// paths.
+type filePacker struct {
+	counter      *packCounter
+	dependencies map[string][]string
+}
+
+func (f filePacker) Pack(files []assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	var directives []assets.WriteDirective
+
+	for _, file := range files {
+		packed, err := f.PackFile(file, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		directives = append(directives, packed...)
+	}
+
+	return directives, nil
+}
+
+func (f filePacker) PackFile(file assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	f.counter.start(file.Path)
+	defer f.counter.done()
+
+	time.Sleep(10 * time.Millisecond)
+
+	data, err := ioutil.ReadFile(file.AbsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return []assets.WriteDirective{{
+		OriginPath:    file.Path,
+		OriginAbsPath: file.AbsPath,
+		Writer:        bytes.NewReader(data),
+	}}, nil
+}
+
+func (f filePacker) Dependencies(file assets.FileStatement, dir assets.DirStatement) ([]string, error) {
+	var paths []string
+	for _, path := range f.dependencies[file.Path] {
+		paths = append(paths, filepath.Join(dir.DirRoot, path))
+	}
+
+	return paths, nil
+}
+
+// groupPacker packs all files together as filePacker does.
+type groupPacker struct {
+	counter *packCounter
+}
+
+func (g groupPacker) Pack(files []assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	return filePacker{counter: g.counter}.Pack(files, dir)
+}
+
+func TestIncrementalBuild(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gu-assets")
+	if err != nil {
+		tests.Failed("Should have successfully created directory: %+q", err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	writeFile := func(path string, content string) {
+		if err := ioutil.WriteFile(filepath.Join(dir, path), []byte(content), 0600); err != nil {
+			tests.Failed("Should have successfully written file %q: %+q", path, err)
+		}
+	}
+
+	for _, path := range []string{"a.txt", "b.txt", "c.txt", "d.txt"} {
+		writeFile(path, path)
+	}
+
+	writeFile("a.inc", "partial")
+	writeFile("x.grp", "x")
+	writeFile("y.grp", "y")
+
+	files := &packCounter{}
+	group := &packCounter{}
+
+	webpack := assets.New(nil, assets.Workers(2))
+	webpack.Register(".txt", filePacker{
+		counter:      files,
+		dependencies: map[string][]string{"a.txt": {"a.inc"}},
+	})
+	webpack.Register(".grp", groupPacker{counter: group})
+
+	build := func() map[string][]assets.WriteDirective {
+		directives, _, err := webpack.Build(dir, false)
+		if err != nil {
+			tests.Failed("Should have successfully built assets: %+q", err)
+		}
+
+		return directives
+	}
+
+	build()
+
+	if packed := files.reset(); len(packed) != 4 {
+		tests.Failed("Should have successfully packed all files: %+q", packed)
+	}
+	tests.Passed("Should have successfully packed all files")
+
+	if files.most > 2 || group.most > 1 {
+		tests.Failed("Should have successfully packed files with at most 2 workers: %d", files.most)
+	}
+	tests.Passed("Should have successfully packed files with at most 2 workers")
+
+	if packed := group.reset(); len(packed) != 2 {
+		tests.Failed("Should have successfully packed group of files: %+q", packed)
+	}
+	tests.Passed("Should have successfully packed group of files")
+
+	directives := build()
+
+	if packed := append(files.reset(), group.reset()...); len(packed) != 0 {
+		tests.Failed("Should have successfully skipped unchanged files: %+q", packed)
+	}
+	tests.Passed("Should have successfully skipped unchanged files")
+
+	if len(directives[".txt"]) != 4 || len(directives[".grp"]) != 2 {
+		tests.Failed("Should have successfully returned directives of unchanged files")
+	}
+
+	for _, directive := range directives[".txt"] {
+		var bu bytes.Buffer
+		if _, err := directive.Writer.WriteTo(&bu); err != nil || bu.String() != directive.OriginPath {
+			tests.Failed("Should have successfully returned content of unchanged file %q: %q", directive.OriginPath, bu.String())
+		}
+	}
+	tests.Passed("Should have successfully returned directives of unchanged files")
+
+	writeFile("b.txt", "b changed")
+	build()
+
+	if packed := files.reset(); len(packed) != 1 || packed[0] != "b.txt" {
+		tests.Failed("Should have successfully repacked only changed file: %+q", packed)
+	}
+	tests.Passed("Should have successfully repacked only changed file")
+
+	writeFile("a.inc", "partial changed")
+	build()
+
+	if packed := files.reset(); len(packed) != 1 || packed[0] != "a.txt" {
+		tests.Failed("Should have successfully repacked only file of changed dependency: %+q", packed)
+	}
+	tests.Passed("Should have successfully repacked only file of changed dependency")
+
+	writeFile("y.grp", "y changed")
+	build()
+
+	if packed := group.reset(); len(packed) != 2 {
+		tests.Failed("Should have successfully repacked group of changed file: %+q", packed)
+	}
+	tests.Passed("Should have successfully repacked group of changed file")
+}
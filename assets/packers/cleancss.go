@@ -25,8 +25,9 @@ var (
 	cleanCSSBin      = filepath.Join(inGOPATHSrc, "github.com/gu-io/gu/node_modules/clean-css-cli/bin")
 )
 
-// CleanCSSPacker defines an implementation for parsing css files.
-// WARNING: Requires Nodejs to be installed.
+// CleanCSSPacker defines an implementation for parsing css files with clean-css.
+// WARNING: Requires Nodejs to be installed, see MinifyCSSPacker for a pure Go
+// implementation.
 type CleanCSSPacker struct {
 	Args []string
 }
@@ -45,6 +46,17 @@ func (cess CleanCSSPacker) Pack(statements []assets.FileStatement, dir assets.Di
 	return directives, nil
 }
 
+// PackFile returns the WriteDirective of the file minified by clean-css.
+func (cess CleanCSSPacker) PackFile(statement assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	var directives []assets.WriteDirective
+
+	if err := processCleanStatement(statement, cess, &directives); err != nil {
+		return nil, err
+	}
+
+	return directives, nil
+}
+
 func processCleanStatement(statement assets.FileStatement, cess CleanCSSPacker, directives *[]assets.WriteDirective) error {
 	args := append([]string{}, cess.Args...)
 	args = append(args, filepath.Clean(statement.AbsPath))
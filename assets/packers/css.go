@@ -2,45 +2,37 @@
 
 package packers
 
-import (
-	"bytes"
-	"io"
-	"os"
+import "github.com/gu-io/gu/assets"
 
-	"github.com/gu-io/gu/assets"
-)
-
-// CSSPacker defines an implementation for parsing css files.
+// CSSPacker defines an implementation for parsing css files, which are minified
+// with the MinifyCSSPacker if CleanCSS is set.
 type CSSPacker struct {
-	CleanCSS bool
+	CleanCSS  bool
+	SourceMap bool
 }
 
 // Pack process all files present in the FileStatment slice and returns WriteDirectives
 // which contains expected outputs for these files.
 func (csp CSSPacker) Pack(statements []assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
-	if csp.CleanCSS {
-		return (CleanCSSPacker{Args: []string{"-O", "1"}}).Pack(statements, dir)
-	}
-
 	var directives []assets.WriteDirective
 
 	for _, statement := range statements {
-		reader, err := os.Open(statement.AbsPath)
+		packed, err := csp.PackFile(statement, dir)
 		if err != nil {
 			return nil, err
 		}
 
-		var bu bytes.Buffer
-		if _, err := io.Copy(&bu, reader); err != nil && err != io.EOF {
-			return nil, err
-		}
-
-		directives = append(directives, assets.WriteDirective{
-			Writer:        &bu,
-			OriginPath:    statement.Path,
-			OriginAbsPath: statement.AbsPath,
-		})
+		directives = append(directives, packed...)
 	}
 
 	return directives, nil
 }
+
+// PackFile returns the WriteDirectives of the file, minified if CleanCSS is set.
+func (csp CSSPacker) PackFile(statement assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	if csp.CleanCSS {
+		return (MinifyCSSPacker{SourceMap: csp.SourceMap}).PackFile(statement, dir)
+	}
+
+	return RawPacker{}.PackFile(statement, dir)
+}
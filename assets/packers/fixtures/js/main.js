@@ -0,0 +1,4 @@
+// main.js greets the visitor of the page.
+var greeting = format("Hello, {name}!", { name: "gu" })
+
+console.log(greeting)
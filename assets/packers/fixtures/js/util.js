@@ -0,0 +1,8 @@
+/*! util.js | MIT License */
+
+// format replaces the {name} placeholders of the template with values.
+function format(template, values) {
+  return template.replace(/\{(\w+)\}/g, function (match, name) {
+    return values[name] !== undefined ? values[name] : match
+  })
+}
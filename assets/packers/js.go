@@ -4,21 +4,76 @@ package packers
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/gu-io/gu/assets"
 )
 
-// JSPacker defines an implementation for parsing css files.
+// JSPacker defines an implementation for parsing javascript files, which are
+// minified with MinifyJS if Minify is set, and concatenated into bundles.
 type JSPacker struct {
 	Exceptions []string
+
+	// Minify sets the packer to minify the files and bundles.
+	Minify bool
+
+	// SourceMap sets the packer to return the source map of each minified file
+	// and each bundle after it, as a file with the ".map" extension referenced
+	// by the javascript.
+	SourceMap bool
+
+	// Bundles maps the paths of bundles to the paths of the files concatenated
+	// into them, in order. Bundles are returned with their content hash in their
+	// Fingerprint, eg. "js/app.5d41402abc4b.js", which their source maps are
+	// referenced by, and the files of bundles are not returned on their own.
+	Bundles map[string][]string
+
+	// Manifest sets the path of a json file, relative to the directory of the
+	// files, which declares bundles in the format of Bundles.
+	Manifest string
 }
 
 // Pack process all files present in the FileStatment slice and returns WriteDirectives
 // which contains expected outputs for these files.
 func (less JSPacker) Pack(statements []assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	bundles, err := less.bundles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]assets.FileStatement, len(statements))
+	for _, statement := range statements {
+		files[filepath.ToSlash(filepath.Clean(statement.Path))] = statement
+	}
+
+	var names []string
+	for name := range bundles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
 	var directives []assets.WriteDirective
+	bundled := make(map[string]bool)
+
+	for _, name := range names {
+		bundle, err := less.packBundle(name, bundles[name], files, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range bundles[name] {
+			bundled[filepath.ToSlash(filepath.Clean(path))] = true
+		}
+
+		directives = append(directives, bundle...)
+	}
 
 	for _, statement := range statements {
 		// Validate that we do not have the relative or absolute path as exceptions.
@@ -26,26 +81,207 @@ func (less JSPacker) Pack(statements []assets.FileStatement, dir assets.DirState
 			continue
 		}
 
-		reader, err := os.Open(statement.AbsPath)
+		if bundled[filepath.ToSlash(filepath.Clean(statement.Path))] {
+			continue
+		}
+
+		if !less.Minify {
+			reader, err := os.Open(statement.AbsPath)
+			if err != nil {
+				return nil, err
+			}
+
+			var bu bytes.Buffer
+			if _, err := io.Copy(&bu, reader); err != nil && err != io.EOF {
+				return nil, err
+			}
+
+			directives = append(directives, assets.WriteDirective{
+				Writer:        &bu,
+				OriginPath:    statement.Path,
+				OriginAbsPath: statement.AbsPath,
+			})
+
+			continue
+		}
+
+		minified, err := less.packFile(statement)
 		if err != nil {
 			return nil, err
 		}
 
-		var bu bytes.Buffer
-		if _, err := io.Copy(&bu, reader); err != nil && err != io.EOF {
-			return nil, err
+		directives = append(directives, minified...)
+	}
+
+	return directives, nil
+}
+
+// packFile returns the directives of the minified file and it's source map.
+func (less JSPacker) packFile(statement assets.FileStatement) ([]assets.WriteDirective, error) {
+	source, err := ioutil.ReadFile(statement.AbsPath)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read file %q: %s", statement.AbsPath, err)
+	}
+
+	var writer jsWriter
+	if less.SourceMap {
+		writer.mapper = new(sourceMapper)
+	}
+
+	if err := writer.minify(string(source), 0); err != nil {
+		return nil, fmt.Errorf("Failed to minify file %q: %s", statement.AbsPath, err)
+	}
+
+	fileName := filepath.Base(statement.Path)
+
+	return less.directives(&writer, statement.Path, statement.AbsPath, []string{fileName}, []string{string(source)})
+}
+
+// packBundle returns the directives of the bundle of the files at the paths and
+// it's source map, fingerprinted with the content hash of the bundle.
+func (less JSPacker) packBundle(name string, paths []string, files map[string]assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	var writer jsWriter
+	if less.SourceMap {
+		writer.mapper = new(sourceMapper)
+	}
+
+	var sources, contents []string
+
+	for index, path := range paths {
+		statement, ok := files[filepath.ToSlash(filepath.Clean(path))]
+		if !ok {
+			return nil, fmt.Errorf("Failed to find file %q of bundle %q", path, name)
+		}
+
+		source, err := ioutil.ReadFile(statement.AbsPath)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read file %q: %s", statement.AbsPath, err)
+		}
+
+		writer.separate()
+
+		if less.Minify {
+			if err := writer.minify(string(source), index); err != nil {
+				return nil, fmt.Errorf("Failed to minify file %q of bundle %q: %s", statement.AbsPath, name, err)
+			}
+		} else {
+			writer.raw(string(source), index)
+		}
+
+		// Sources of source maps are relative to the directory of the map.
+		rel, err := filepath.Rel(filepath.Dir(name), statement.Path)
+		if err != nil {
+			rel = statement.Path
 		}
 
-		directives = append(directives, assets.WriteDirective{
-			Writer:        &bu,
-			OriginPath:    statement.Path,
-			OriginAbsPath: statement.AbsPath,
-		})
+		sources = append(sources, filepath.ToSlash(rel))
+		contents = append(contents, string(source))
 	}
 
+	hash := assets.ContentHash(writer.Bytes())
+	fingerprint := assets.HashedPath(name, hash)
+
+	directives, err := less.directives(&writer, fingerprint, filepath.Join(dir.DirRoot, fingerprint), sources, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	// The bundle references it's source map by it's fingerprinted path, so both
+	// are fingerprinted by the hash of the bundle.
+	for index, suffix := range []string{"", ".map"}[:len(directives)] {
+		directives[index].OriginPath = name + suffix
+		directives[index].OriginAbsPath = filepath.Join(dir.DirRoot, name+suffix)
+		directives[index].Fingerprint = fingerprint + suffix
+	}
+
+	directives[0].Hash = hash
+
 	return directives, nil
 }
 
+// directives returns the directive of the content of the writer at the path,
+// followed by the directive of it's source map if the writer has a mapper.
+func (less JSPacker) directives(writer *jsWriter, path string, absPath string, sources []string, contents []string) ([]assets.WriteDirective, error) {
+	content := writer.Bytes()
+
+	if writer.mapper == nil {
+		return []assets.WriteDirective{{
+			Writer:        bytes.NewReader(content),
+			OriginPath:    path,
+			OriginAbsPath: absPath,
+		}}, nil
+	}
+
+	fileName := filepath.Base(path)
+
+	srcMap, err := writer.mapper.Map(fileName, sources, contents)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create source map of file %q: %s", path, err)
+	}
+
+	content = append(content, "\n//# sourceMappingURL="+fileName+".map"...)
+
+	return []assets.WriteDirective{{
+		Writer:        bytes.NewReader(content),
+		OriginPath:    path,
+		OriginAbsPath: absPath,
+	}, {
+		Writer:        bytes.NewReader(srcMap),
+		OriginPath:    path + ".map",
+		OriginAbsPath: absPath + ".map",
+	}}, nil
+}
+
+// Dependencies returns the absolute path of the manifest if set, as it declares
+// the bundles of the files.
+func (less JSPacker) Dependencies(statement assets.FileStatement, dir assets.DirStatement) ([]string, error) {
+	if less.Manifest == "" {
+		return nil, nil
+	}
+
+	return []string{less.manifestPath(dir)}, nil
+}
+
+// manifestPath returns the absolute path of the manifest.
+func (less JSPacker) manifestPath(dir assets.DirStatement) string {
+	if filepath.IsAbs(less.Manifest) {
+		return less.Manifest
+	}
+
+	return filepath.Join(dir.DirRoot, less.Manifest)
+}
+
+// bundles returns the bundles of the packer with the bundles declared by it's
+// manifest, which replace the bundles of the same paths.
+func (less JSPacker) bundles(dir assets.DirStatement) (map[string][]string, error) {
+	bundles := make(map[string][]string, len(less.Bundles))
+	for name, paths := range less.Bundles {
+		bundles[name] = paths
+	}
+
+	if less.Manifest == "" {
+		return bundles, nil
+	}
+
+	manifestPath := less.manifestPath(dir)
+
+	data, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read manifest %q: %s", manifestPath, err)
+	}
+
+	var manifest map[string][]string
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("Failed to parse manifest %q: %s", manifestPath, err)
+	}
+
+	for name, paths := range manifest {
+		bundles[name] = paths
+	}
+
+	return bundles, nil
+}
+
 // hasException validates the path is not within the exception list.
 func (less JSPacker) hasException(path string) bool {
 	for _, pl := range less.Exceptions {
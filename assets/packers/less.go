@@ -6,9 +6,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
 	gexec "os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -19,6 +21,9 @@ import (
 
 var (
 	lessBin = filepath.Join(inGOPATHSrc, "github.com/gu-io/gu/node_modules/less/bin")
+
+	// lessImport matches the options and path of a @import of a less file.
+	lessImport = regexp.MustCompile(`@import\s*(?:\(([^)]*)\))?\s*(?:url\(\s*)?["']([^"']+)["']`)
 )
 
 // LessPacker defines an implementation for parsing .less files into css files using the less compiler in nodejs.
@@ -33,28 +38,93 @@ type LessPacker struct {
 func (less LessPacker) Pack(statements []assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
 	var directives []assets.WriteDirective
 
-	// If main less file has being set then attempt to find main file.
-	if less.MainFile == "" {
-		for _, statement := range statements {
-			if err := processStatement(statement, less, &directives); err != nil {
-				return nil, err
-			}
+	for _, statement := range statements {
+		packed, err := less.PackFile(statement, dir)
+		if err != nil {
+			return nil, err
 		}
 
-		return directives, nil
+		directives = append(directives, packed...)
 	}
 
-	for _, statement := range statements {
-		if statement.Path != less.MainFile {
+	return directives, nil
+}
+
+// PackFile returns the WriteDirective of the css of the file, which is only
+// returned for the main file if MainFile is set.
+func (less LessPacker) PackFile(statement assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	if less.MainFile != "" && statement.Path != less.MainFile {
+		return nil, nil
+	}
+
+	var directives []assets.WriteDirective
+
+	if err := processStatement(statement, less, &directives); err != nil {
+		return nil, err
+	}
+
+	return directives, nil
+}
+
+// Dependencies returns the absolute paths of the files imported by the file with
+// @import, including the files they import.
+func (less LessPacker) Dependencies(statement assets.FileStatement, dir assets.DirStatement) ([]string, error) {
+	var paths []string
+
+	seen := map[string]bool{filepath.Clean(statement.AbsPath): true}
+	if err := lessImports(statement.AbsPath, seen, &paths); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// lessImports adds the paths of the files imported by the less file at path
+// which are not seen into paths, followed by their own imports.
+func lessImports(path string, seen map[string]bool, paths *[]string) error {
+	source, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Failed to read file %q: %s", path, err)
+	}
+
+	for _, match := range lessImport.FindAllStringSubmatch(string(source), -1) {
+		options, target := match[1], match[2]
+
+		if strings.Contains(target, "://") {
 			continue
 		}
 
-		if err := processStatement(statement, less, &directives); err != nil {
-			return nil, err
+		// Imports of css files are kept by less as they are, except when
+		// imported with the less or inline options.
+		switch filepath.Ext(target) {
+		case "":
+			target += ".less"
+		case ".css":
+			if !strings.Contains(options, "less") && !strings.Contains(options, "inline") {
+				continue
+			}
+		}
+
+		imported := filepath.Join(filepath.Dir(path), filepath.FromSlash(target))
+		if seen[imported] {
+			continue
+		}
+
+		seen[imported] = true
+		*paths = append(*paths, imported)
+
+		// Missing imports are returned without their own imports, so the file is
+		// repacked once they are created.
+		if _, err := os.Stat(imported); err != nil {
+			continue
+		}
+
+		if err := lessImports(imported, seen, paths); err != nil {
+			return err
 		}
 	}
 
-	return directives, nil
+	return nil
 }
 
 func processStatement(statement assets.FileStatement, less LessPacker, directives *[]assets.WriteDirective) error {
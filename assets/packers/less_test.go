@@ -50,3 +50,24 @@ func TestLessPacker(t *testing.T) {
 	}
 	tests.Passed("Should have successfully matched css output with expected")
 }
+
+func TestLessPackerDependencies(t *testing.T) {
+	fixtures := filepath.Join(thisSrc, "assets/packers/fixtures")
+
+	var less packers.LessPacker
+
+	dependencies, err := less.Dependencies(assets.FileStatement{
+		Path:    "bomb.less",
+		AbsPath: filepath.Join(fixtures, "bomb.less"),
+	}, assets.DirStatement{DirRoot: fixtures})
+
+	if err != nil {
+		tests.Failed("Should have successfully found imports of less file: %+q", err)
+	}
+	tests.Passed("Should have successfully found imports of less file")
+
+	if len(dependencies) != 1 || dependencies[0] != filepath.Join(fixtures, "others", "vars.less") {
+		tests.Failed("Should have successfully returned imported less file: %+q", dependencies)
+	}
+	tests.Passed("Should have successfully returned imported less file")
+}
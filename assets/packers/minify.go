@@ -0,0 +1,904 @@
+// +build !js
+
+package packers
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/aymerick/douceur/css"
+	"github.com/aymerick/douceur/parser"
+	"github.com/gorilla/css/scanner"
+	"github.com/gu-io/gu/assets"
+)
+
+// MinifyCSSPacker defines an implementation for minifying css files in pure Go,
+// which needs no Nodejs. It removes comments and whitespace, shortens numbers,
+// colors and shorthands, and collapses duplicate rules.
+type MinifyCSSPacker struct {
+	// SourceMap sets the packer to return the source map of each file after it,
+	// as a file with the ".map" extension referenced by the minified css.
+	SourceMap bool
+}
+
+// Pack process all files present in the FileStatment slice and returns WriteDirectives
+// which contains the minified outputs for these files.
+func (mcp MinifyCSSPacker) Pack(statements []assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	var directives []assets.WriteDirective
+
+	for _, statement := range statements {
+		packed, err := mcp.PackFile(statement, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		directives = append(directives, packed...)
+	}
+
+	return directives, nil
+}
+
+// PackFile returns the WriteDirective of the minified file, followed by it's
+// source map if SourceMap is set.
+func (mcp MinifyCSSPacker) PackFile(statement assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	source, err := ioutil.ReadFile(statement.AbsPath)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read file %q: %s", statement.AbsPath, err)
+	}
+
+	var mapper *sourceMapper
+	if mcp.SourceMap {
+		mapper = new(sourceMapper)
+	}
+
+	minified, err := minifyCSS(string(source), mapper)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to minify file %q: %s", statement.AbsPath, err)
+	}
+
+	if mapper == nil {
+		return []assets.WriteDirective{{
+			Writer:        bytes.NewReader([]byte(minified)),
+			OriginPath:    statement.Path,
+			OriginAbsPath: statement.AbsPath,
+		}}, nil
+	}
+
+	fileName := filepath.Base(statement.Path)
+
+	srcMap, err := mapper.Map(fileName, []string{fileName}, []string{string(source)})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create source map of file %q: %s", statement.AbsPath, err)
+	}
+
+	minified += "\n/*# sourceMappingURL=" + fileName + ".map */"
+
+	return []assets.WriteDirective{{
+		Writer:        bytes.NewReader([]byte(minified)),
+		OriginPath:    statement.Path,
+		OriginAbsPath: statement.AbsPath,
+	}, {
+		Writer:        bytes.NewReader(srcMap),
+		OriginPath:    statement.Path + ".map",
+		OriginAbsPath: statement.AbsPath + ".map",
+	}}, nil
+}
+
+// MinifyCSS returns the minified version of the css source.
+func MinifyCSS(source string) (string, error) {
+	return minifyCSS(source, nil)
+}
+
+// minifyCSS returns the minified version of the css source, adding the positions
+// of it's rules and declarations to the mapper if it's not nil.
+func minifyCSS(source string, mapper *sourceMapper) (string, error) {
+	sheet, err := parser.Parse(source)
+	if err != nil {
+		return "", err
+	}
+
+	rules := optimizeRules(convertRules(sheet.Rules, scanNodes(scanner.New(source))))
+
+	writer := cssWriter{mapper: mapper}
+	writer.rules(rules)
+
+	return writer.String(), nil
+}
+
+//==============================================================================
+
+// cssPosition defines a position within a css source, where lines and columns
+// start from zero.
+type cssPosition struct {
+	line   int
+	column int
+	ok     bool
+}
+
+// cssNode defines the position of a rule or declaration within a css source and
+// the nodes of it's block.
+type cssNode struct {
+	pos      cssPosition
+	block    bool
+	children []cssNode
+}
+
+// scanNodes returns the nodes of the statements of the scanner until the end of
+// the current block, in the order the douceur parser returns them.
+func scanNodes(scan *scanner.Scanner) []cssNode {
+	var nodes []cssNode
+
+	for {
+		token := scan.Next()
+
+		switch {
+		case token.Type == scanner.TokenEOF, token.Type == scanner.TokenError:
+			return nodes
+		case ignorableToken(token):
+			continue
+		case charToken(token, "}"):
+			return nodes
+		}
+
+		node := cssNode{
+			pos: cssPosition{line: token.Line - 1, column: token.Column - 1, ok: true},
+		}
+
+	statement:
+		for ; ; token = scan.Next() {
+			switch {
+			case token.Type == scanner.TokenEOF, token.Type == scanner.TokenError:
+				return append(nodes, node)
+			case charToken(token, "}"):
+				return append(nodes, node)
+			case charToken(token, ";"):
+				break statement
+			case charToken(token, "{"):
+				node.block = true
+				node.children = scanNodes(scan)
+				break statement
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+}
+
+// ignorableToken returns true if the token is skipped between statements.
+func ignorableToken(token *scanner.Token) bool {
+	switch token.Type {
+	case scanner.TokenS, scanner.TokenComment, scanner.TokenCDO, scanner.TokenCDC, scanner.TokenBOM:
+		return true
+	default:
+		return false
+	}
+}
+
+// charToken returns true if the token is the character.
+func charToken(token *scanner.Token, char string) bool {
+	return token.Type == scanner.TokenChar && token.Value == char
+}
+
+//==============================================================================
+
+// cssRule defines a rule of a stylesheet being minified, which is an at-rule if
+// it has a name.
+type cssRule struct {
+	name      string
+	prelude   string
+	selectors []string
+	block     bool
+	nested    bool
+	rules     []*cssRule
+	decls     []cssDecl
+	pos       cssPosition
+}
+
+// cssDecl defines a declaration of a rule being minified.
+type cssDecl struct {
+	property  string
+	value     string
+	important bool
+	pos       cssPosition
+}
+
+// convertRules returns the rules parsed by douceur with their selectors,
+// preludes and values compacted, positioned with the nodes if they match.
+func convertRules(rules []*css.Rule, nodes []cssNode) []*cssRule {
+	result := make([]*cssRule, 0, len(rules))
+
+	for index, rule := range rules {
+		var node cssNode
+		if len(nodes) == len(rules) {
+			node = nodes[index]
+		}
+
+		item := &cssRule{
+			pos:    node.pos,
+			nested: rule.EmbedsRules(),
+			block:  node.block || rule.Kind == css.QualifiedRule || rule.EmbedsRules() || len(rule.Declarations) != 0,
+		}
+
+		if rule.Kind == css.AtRule {
+			item.name = rule.Name
+			item.prelude = strings.Join(compactCSS(rule.Prelude, ",:", false), "")
+		} else {
+			item.selectors = uniqueStrings(compactCSS(rule.Prelude, ",>+~", true))
+		}
+
+		if item.nested {
+			item.rules = convertRules(rule.Rules, node.children)
+		} else {
+			item.decls = convertDecls(rule.Declarations, node.children)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// convertDecls returns the declarations parsed by douceur with their values
+// compacted, positioned with the nodes if they match.
+func convertDecls(decls []*css.Declaration, nodes []cssNode) []cssDecl {
+	result := make([]cssDecl, 0, len(decls))
+
+	for index, decl := range decls {
+		var node cssNode
+		if len(nodes) == len(decls) {
+			node = nodes[index]
+		}
+
+		// Custom properties are case sensitive and keep their values as written.
+		property := decl.Property
+		value := decl.Value
+
+		if !strings.HasPrefix(property, "--") {
+			property = strings.ToLower(property)
+			value = compactValue(property, value)
+
+			if value == "" {
+				continue
+			}
+		}
+
+		result = append(result, cssDecl{
+			property:  property,
+			value:     value,
+			important: decl.Important,
+			pos:       node.pos,
+		})
+	}
+
+	return result
+}
+
+//==============================================================================
+
+// compactCSS returns the text without comments and with whitespace collapsed,
+// where whitespace next to parenthesis, brackets and the characters of tight is
+// removed. The text is split at the commas outside of parenthesis and brackets
+// if split is true.
+func compactCSS(text string, tight string, split bool) []string {
+	return joinTokens(cssTokens(text), tight, split)
+}
+
+// cssTokens returns the tokens of the css text, without comments and with runs of
+// whitespace replaced by a single whitespace token.
+func cssTokens(text string) []*scanner.Token {
+	var tokens []*scanner.Token
+
+	scan := scanner.New(text)
+
+	for {
+		token := scan.Next()
+
+		switch token.Type {
+		case scanner.TokenEOF, scanner.TokenError:
+			if len(tokens) != 0 && tokens[len(tokens)-1].Type == scanner.TokenS {
+				tokens = tokens[:len(tokens)-1]
+			}
+
+			return tokens
+		case scanner.TokenS, scanner.TokenComment:
+			if len(tokens) != 0 && tokens[len(tokens)-1].Type != scanner.TokenS {
+				tokens = append(tokens, &scanner.Token{Type: scanner.TokenS, Value: " "})
+			}
+		default:
+			tokens = append(tokens, token)
+		}
+	}
+}
+
+// joinTokens returns the text of the tokens, dropping the whitespace tokens next
+// to parenthesis, brackets and the characters of tight. The text is split at the
+// commas outside of parenthesis and brackets if split is true.
+func joinTokens(tokens []*scanner.Token, tight string, split bool) []string {
+	var parts []string
+	var depth int
+	var bu bytes.Buffer
+
+	for index, token := range tokens {
+		if token.Type == scanner.TokenS {
+			if index == 0 || tightToken(tokens[index-1], tight, true) || tightToken(tokens[index+1], tight, false) {
+				continue
+			}
+		}
+
+		switch {
+		case token.Type == scanner.TokenFunction, charToken(token, "("), charToken(token, "["):
+			depth++
+		case charToken(token, ")"), charToken(token, "]"):
+			depth--
+		case split && depth == 0 && charToken(token, ","):
+			parts = append(parts, bu.String())
+			bu.Reset()
+			continue
+		}
+
+		bu.WriteString(token.Value)
+	}
+
+	return append(parts, bu.String())
+}
+
+// tightToken returns true if the whitespace after the token, or before it if
+// before is false, can be removed.
+func tightToken(token *scanner.Token, tight string, before bool) bool {
+	if before && token.Type == scanner.TokenFunction {
+		return true
+	}
+
+	if token.Type != scanner.TokenChar {
+		return false
+	}
+
+	switch token.Value {
+	case "(", "[":
+		return before
+	case ")", "]":
+		return !before
+	}
+
+	return strings.Contains(tight, token.Value)
+}
+
+// uniqueStrings returns the items without repeated items.
+func uniqueStrings(items []string) []string {
+	seen := make(map[string]bool, len(items))
+
+	var result []string
+	for _, item := range items {
+		if seen[item] {
+			continue
+		}
+
+		seen[item] = true
+		result = append(result, item)
+	}
+
+	return result
+}
+
+//==============================================================================
+
+// lengthUnits defines the units of lengths which can be dropped from zero
+// values.
+var lengthUnits = map[string]bool{
+	"px": true, "em": true, "rem": true, "ex": true, "ch": true,
+	"vw": true, "vh": true, "vmin": true, "vmax": true,
+	"cm": true, "mm": true, "in": true, "pt": true, "pc": true, "q": true,
+}
+
+// fontWeights defines the numeric values of the font-weight keywords.
+var fontWeights = map[string]string{
+	"normal": "400",
+	"bold":   "700",
+}
+
+// compactValue returns the minified value of the property, with it's numbers,
+// colors and box shorthand shortened.
+func compactValue(property string, value string) string {
+	tokens := cssTokens(value)
+
+	var depth int
+	for _, token := range tokens {
+		switch token.Type {
+		case scanner.TokenFunction:
+			depth++
+		case scanner.TokenChar:
+			switch token.Value {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			}
+		case scanner.TokenNumber:
+			token.Value = shortenNumber(token.Value)
+		case scanner.TokenPercentage:
+			token.Value = shortenNumber(strings.TrimSuffix(token.Value, "%")) + "%"
+		case scanner.TokenDimension:
+			number, unit := splitDimension(token.Value)
+			number = shortenNumber(number)
+
+			// Units are kept within functions like calc, which need them,
+			// and for the basis of flex, which needs them in older browsers.
+			if number == "0" && depth == 0 && lengthUnits[strings.ToLower(unit)] && !strings.HasSuffix(property, "flex") {
+				unit = ""
+			}
+
+			token.Value = number + unit
+		case scanner.TokenHash:
+			token.Value = shortenColor(token.Value)
+		}
+	}
+
+	value = strings.Join(joinTokens(tokens, ",/", false), "")
+
+	if weight, ok := fontWeights[strings.ToLower(value)]; ok && property == "font-weight" {
+		return weight
+	}
+
+	return shortenBox(property, value)
+}
+
+// splitDimension returns the number and unit of a dimension.
+func splitDimension(dimension string) (string, string) {
+	for index, char := range dimension {
+		if (char < '0' || char > '9') && char != '.' && char != '-' && char != '+' {
+			return dimension[:index], dimension[index:]
+		}
+	}
+
+	return dimension, ""
+}
+
+// shortenNumber returns the number without leading and trailing zeros, eg.
+// "0.50" as ".5".
+func shortenNumber(number string) string {
+	var sign string
+	if strings.HasPrefix(number, "-") || strings.HasPrefix(number, "+") {
+		sign, number = number[:1], number[1:]
+	}
+
+	if number == "" || strings.Trim(number, "0123456789.") != "" || strings.Count(number, ".") > 1 {
+		return sign + number
+	}
+
+	whole, fraction := number, ""
+	if index := strings.IndexByte(number, '.'); index != -1 {
+		whole, fraction = number[:index], strings.TrimRight(number[index+1:], "0")
+	}
+
+	whole = strings.TrimLeft(whole, "0")
+
+	switch {
+	case whole == "" && fraction == "":
+		return "0"
+	case fraction == "":
+		return sign + whole
+	default:
+		return sign + whole + "." + fraction
+	}
+}
+
+// shortenColor returns the hex color in lowercase and in it's short form if it
+// has one, eg. "#FFCC00" as "#fc0".
+func shortenColor(hash string) string {
+	hex := strings.ToLower(strings.TrimPrefix(hash, "#"))
+	if strings.Trim(hex, "0123456789abcdef") != "" {
+		return hash
+	}
+
+	switch len(hex) {
+	case 3, 4:
+		return "#" + hex
+	case 6, 8:
+		short := make([]byte, 0, len(hex)/2)
+
+		for index := 0; index < len(hex); index += 2 {
+			if hex[index] != hex[index+1] {
+				return "#" + hex
+			}
+
+			short = append(short, hex[index])
+		}
+
+		return "#" + string(short)
+	default:
+		return hash
+	}
+}
+
+//==============================================================================
+
+// boxShorthands defines the shorthands of the top, right, bottom and left
+// longhands of a box.
+var boxShorthands = map[string][4]string{
+	"margin":       boxLonghands("margin-", ""),
+	"padding":      boxLonghands("padding-", ""),
+	"border-width": boxLonghands("border-", "-width"),
+	"border-style": boxLonghands("border-", "-style"),
+	"border-color": boxLonghands("border-", "-color"),
+}
+
+// boxLonghands returns the longhands of the sides of a box, in the order of
+// it's shorthand.
+func boxLonghands(prefix string, suffix string) [4]string {
+	return [4]string{
+		prefix + "top" + suffix,
+		prefix + "right" + suffix,
+		prefix + "bottom" + suffix,
+		prefix + "left" + suffix,
+	}
+}
+
+// wideKeywords defines the keywords valid for all properties, which can not be
+// combined with other values in shorthands.
+var wideKeywords = map[string]bool{
+	"inherit": true,
+	"initial": true,
+	"unset":   true,
+	"revert":  true,
+}
+
+// shortenBox returns the value of a box shorthand with the repeated sides
+// removed, eg. "0 1px 0 1px" as "0 1px".
+func shortenBox(property string, value string) string {
+	if _, ok := boxShorthands[property]; !ok || strings.Contains(value, "(") {
+		return value
+	}
+
+	parts := strings.Split(value, " ")
+
+	if len(parts) == 4 && parts[3] == parts[1] {
+		parts = parts[:3]
+	}
+
+	if len(parts) == 3 && parts[2] == parts[0] {
+		parts = parts[:2]
+	}
+
+	if len(parts) == 2 && parts[1] == parts[0] {
+		parts = parts[:1]
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// shorthandOf returns the box shorthand of the longhand property.
+func shorthandOf(property string) (string, bool) {
+	for shorthand, longhands := range boxShorthands {
+		for _, longhand := range longhands {
+			if longhand == property {
+				return shorthand, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// overrides returns true if the later declaration of a rule makes the earlier
+// declaration redundant. Declarations of a property with different values are
+// kept, as earlier ones are fallbacks for browsers not supporting later ones.
+func overrides(later cssDecl, earlier cssDecl) bool {
+	if earlier.important && !later.important {
+		return false
+	}
+
+	if later.property == earlier.property {
+		return later.value == earlier.value || strings.HasPrefix(later.property, "--")
+	}
+
+	shorthand, ok := shorthandOf(earlier.property)
+	return ok && shorthand == later.property && !strings.Contains(later.value, "(")
+}
+
+// optimizeDecls returns the declarations without the ones overridden by later
+// declarations, with the longhands of boxes merged into their shorthands.
+func optimizeDecls(decls []cssDecl) []cssDecl {
+	var result []cssDecl
+
+	for index, decl := range decls {
+		var overridden bool
+
+		for _, later := range decls[index+1:] {
+			if overrides(later, decl) {
+				overridden = true
+				break
+			}
+		}
+
+		if !overridden {
+			result = append(result, decl)
+		}
+	}
+
+	for shorthand, longhands := range boxShorthands {
+		result = mergeLonghands(result, shorthand, longhands)
+	}
+
+	return result
+}
+
+// mergeLonghands returns the declarations with the longhands of the sides of a
+// box replaced by their shorthand, at the position of the last of them. The
+// declarations are returned as is unless each side is declared once, with the
+// same importance and with a single value.
+func mergeLonghands(decls []cssDecl, shorthand string, longhands [4]string) []cssDecl {
+	found := [4]int{-1, -1, -1, -1}
+
+	for index, decl := range decls {
+		for side, longhand := range longhands {
+			if decl.property != longhand {
+				continue
+			}
+
+			if found[side] != -1 || strings.ContainsAny(decl.value, " (") || wideKeywords[decl.value] {
+				return decls
+			}
+
+			found[side] = index
+		}
+	}
+
+	var values [4]string
+	var last int
+
+	for side, index := range found {
+		if index == -1 || decls[index].important != decls[found[0]].important {
+			return decls
+		}
+
+		if index > last {
+			last = index
+		}
+
+		values[side] = decls[index].value
+	}
+
+	merged := cssDecl{
+		property:  shorthand,
+		value:     shortenBox(shorthand, strings.Join(values[:], " ")),
+		important: decls[last].important,
+		pos:       decls[found[0]].pos,
+	}
+
+	var result []cssDecl
+
+	for index, decl := range decls {
+		switch index {
+		case last:
+			result = append(result, merged)
+		case found[0], found[1], found[2], found[3]:
+		default:
+			result = append(result, decl)
+		}
+	}
+
+	return result
+}
+
+//==============================================================================
+
+// optimizeRules returns the rules with their declarations optimized, empty rules
+// removed and duplicate rules collapsed.
+func optimizeRules(rules []*cssRule) []*cssRule {
+	var result []*cssRule
+
+	for _, rule := range rules {
+		if rule.nested {
+			rule.rules = optimizeRules(rule.rules)
+		} else {
+			rule.decls = optimizeDecls(rule.decls)
+		}
+
+		if rule.block && len(rule.rules) == 0 && len(rule.decls) == 0 {
+			continue
+		}
+
+		if len(result) != 0 && mergeRules(result[len(result)-1], rule) {
+			continue
+		}
+
+		result = append(result, rule)
+	}
+
+	return dropDuplicateRules(result)
+}
+
+// mergeRules merges the rule into the previous rule if they are adjacent rules
+// of the same selectors or declarations, or adjacent at-rules of the same
+// prelude, returning true if it merged them.
+func mergeRules(previous *cssRule, rule *cssRule) bool {
+	if previous.name != "" || rule.name != "" {
+		if !previous.nested || !rule.nested || previous.name != rule.name || previous.prelude != rule.prelude {
+			return false
+		}
+
+		previous.rules = optimizeRules(append(previous.rules, rule.rules...))
+		return true
+	}
+
+	if strings.Join(previous.selectors, ",") == strings.Join(rule.selectors, ",") {
+		previous.decls = optimizeDecls(append(previous.decls, rule.decls...))
+		return true
+	}
+
+	// Browsers drop rules with selectors they do not support, so vendor
+	// prefixed selectors are kept in rules of their own.
+	if vendorSelectors(previous.selectors) || vendorSelectors(rule.selectors) {
+		return false
+	}
+
+	if declsKey(previous.decls) != declsKey(rule.decls) {
+		return false
+	}
+
+	previous.selectors = uniqueStrings(append(previous.selectors, rule.selectors...))
+	return true
+}
+
+// dropDuplicateRules returns the rules without the rules whose declarations are
+// all overridden by a later rule of the same selectors, or repeated later.
+func dropDuplicateRules(rules []*cssRule) []*cssRule {
+	var result []*cssRule
+
+	for index, rule := range rules {
+		var repeated bool
+
+		for _, later := range rules[index+1:] {
+			if coveredBy(rule, later) {
+				repeated = true
+				break
+			}
+		}
+
+		if !repeated {
+			result = append(result, rule)
+		}
+	}
+
+	return result
+}
+
+// coveredBy returns true if the later rule makes the rule redundant.
+func coveredBy(rule *cssRule, later *cssRule) bool {
+	if !rule.block {
+		return false
+	}
+
+	if rule.name != "" || rule.nested {
+		return ruleKey(rule) == ruleKey(later)
+	}
+
+	if later.name != "" || strings.Join(rule.selectors, ",") != strings.Join(later.selectors, ",") {
+		return false
+	}
+
+	for _, decl := range rule.decls {
+		var overridden bool
+
+		for _, override := range later.decls {
+			if overrides(override, decl) {
+				overridden = true
+				break
+			}
+		}
+
+		if !overridden {
+			return false
+		}
+	}
+
+	return true
+}
+
+// vendorSelectors returns true if any of the selectors has vendor prefixed
+// pseudo classes or elements.
+func vendorSelectors(selectors []string) bool {
+	for _, selector := range selectors {
+		if strings.Contains(selector, ":-") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ruleKey returns the minified css of the rule.
+func ruleKey(rule *cssRule) string {
+	var writer cssWriter
+	writer.rules([]*cssRule{rule})
+	return writer.String()
+}
+
+// declsKey returns the minified css of the declarations.
+func declsKey(decls []cssDecl) string {
+	var writer cssWriter
+	writer.decls(decls)
+	return writer.String()
+}
+
+//==============================================================================
+
+// cssWriter writes minified rules on a single line, adding the positions of the
+// rules and declarations it writes to it's mapper if it has one.
+type cssWriter struct {
+	bytes.Buffer
+	column int
+	mapper *sourceMapper
+}
+
+// write writes the text, counting the columns of it in UTF-16 units as used
+// by source maps.
+func (w *cssWriter) write(text string) {
+	w.WriteString(text)
+
+	for _, char := range text {
+		w.column++
+
+		if char >= 0x10000 {
+			w.column++
+		}
+	}
+}
+
+// mark maps the current column to the position.
+func (w *cssWriter) mark(pos cssPosition) {
+	if w.mapper != nil && pos.ok {
+		w.mapper.Add(0, w.column, 0, pos.line, pos.column)
+	}
+}
+
+// rules writes the rules.
+func (w *cssWriter) rules(rules []*cssRule) {
+	for _, rule := range rules {
+		w.mark(rule.pos)
+
+		if rule.name == "" {
+			w.write(strings.Join(rule.selectors, ","))
+		} else {
+			w.write(rule.name)
+
+			if rule.prelude != "" {
+				w.write(" " + rule.prelude)
+			}
+		}
+
+		if !rule.block {
+			w.write(";")
+			continue
+		}
+
+		w.write("{")
+
+		if rule.nested {
+			w.rules(rule.rules)
+		} else {
+			w.decls(rule.decls)
+		}
+
+		w.write("}")
+	}
+}
+
+// decls writes the declarations.
+func (w *cssWriter) decls(decls []cssDecl) {
+	for index, decl := range decls {
+		if index != 0 {
+			w.write(";")
+		}
+
+		w.mark(decl.pos)
+		w.write(decl.property + ":" + decl.value)
+
+		if decl.important {
+			w.write("!important")
+		}
+	}
+}
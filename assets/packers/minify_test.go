@@ -0,0 +1,174 @@
+package packers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/assets"
+	"github.com/gu-io/gu/assets/packers"
+	"github.com/influx6/faux/tests"
+)
+
+func TestMinifyCSSPacker(t *testing.T) {
+	expected := "html,body,div.tuglife{width:100%;height:100%}"
+	fixtures := filepath.Join(thisSrc, "assets/packers/fixtures")
+	wordan := filepath.Join(fixtures, "wordan.css")
+	wordanRel := filepath.Join("./packers/fixtures/", "wordan.css")
+
+	var minify packers.MinifyCSSPacker
+
+	response, err := minify.Pack([]assets.FileStatement{{
+		Path:    wordanRel,
+		AbsPath: wordan,
+	}}, assets.DirStatement{})
+
+	if err != nil {
+		tests.Failed("Should have successfully packed css file: %+q", err)
+	}
+	tests.Passed("Should have successfully packed css file")
+
+	if len(response) != 1 {
+		tests.Failed("Should have successfully received minified css file")
+	}
+	tests.Passed("Should have successfully received minified css file")
+
+	var b bytes.Buffer
+	if _, err := response[0].Writer.WriteTo(&b); err != nil {
+		tests.Failed("Should have successfully written data to buffer: %+q", err)
+	}
+	tests.Passed("Should have successfully written data to buffer")
+
+	if b.String() != expected {
+		tests.Info("Expected: %+q", expected)
+		tests.Info("Received: %+q", b.String())
+		tests.Failed("Should have successfully matched css output with expected")
+	}
+	tests.Passed("Should have successfully matched css output with expected")
+}
+
+func TestMinifyCSS(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Source   string
+		Expected string
+	}{
+		{
+			Name:     "comments and whitespace",
+			Source:   "/* header */\na > b ,  c + d {\n  color : red ; /* color */\n  font-family: \"Open  Sans\" , serif;\n}",
+			Expected: `a>b,c+d{color:red;font-family:"Open  Sans",serif}`,
+		},
+		{
+			Name:     "numbers and colors",
+			Source:   "div { margin: 0px 0.50em 0px 0.50em; color: #FFCC00; width: calc(100% - 0px); opacity: 0.80; font-weight: bold }",
+			Expected: "div{margin:0 .5em;color:#fc0;width:calc(100% - 0px);opacity:.8;font-weight:700}",
+		},
+		{
+			Name:     "box longhands",
+			Source:   "p { padding-top: 1px; padding-right: 2px; color: red; padding-bottom: 1px; padding-left: 2px }",
+			Expected: "p{color:red;padding:1px 2px}",
+		},
+		{
+			Name:     "overridden declarations",
+			Source:   "p { margin-top: 4px; color: red; margin: 0; color: red; display: -webkit-box; display: flex }",
+			Expected: "p{margin:0;color:red;display:-webkit-box;display:flex}",
+		},
+		{
+			Name:     "important declarations",
+			Source:   "p { margin-top: 4px !important; margin: 0 }",
+			Expected: "p{margin-top:4px!important;margin:0}",
+		},
+		{
+			Name:     "duplicate rules",
+			Source:   "a { color: red } b { color: blue } a { color: red } a { margin: 0 } p {}",
+			Expected: "b{color:blue}a{color:red;margin:0}",
+		},
+		{
+			Name:     "vendor selectors",
+			Source:   "::-moz-selection { color: red } ::selection { color: red }",
+			Expected: "::-moz-selection{color:red}::selection{color:red}",
+		},
+		{
+			Name:     "at-rules",
+			Source:   "@import url(\"base.css\");\n@media screen and (max-width : 600px) { a { color: red } }\n@media screen and (max-width : 600px) { b { color: red } }",
+			Expected: `@import url("base.css");@media screen and (max-width:600px){a,b{color:red}}`,
+		},
+	}
+
+	for _, item := range cases {
+		minified, err := packers.MinifyCSS(item.Source)
+		if err != nil {
+			tests.Failed("Should have successfully minified %s: %+q", item.Name, err)
+		}
+
+		if minified != item.Expected {
+			tests.Info("Expected: %+q", item.Expected)
+			tests.Info("Received: %+q", minified)
+			tests.Failed("Should have successfully minified %s", item.Name)
+		}
+		tests.Passed("Should have successfully minified %s", item.Name)
+	}
+}
+
+func TestMinifyCSSSourceMap(t *testing.T) {
+	fixtures := filepath.Join(thisSrc, "assets/packers/fixtures")
+	wordan := filepath.Join(fixtures, "wordan.css")
+	wordanRel := filepath.Join("./packers/fixtures/", "wordan.css")
+
+	minify := packers.MinifyCSSPacker{SourceMap: true}
+
+	response, err := minify.Pack([]assets.FileStatement{{
+		Path:    wordanRel,
+		AbsPath: wordan,
+	}}, assets.DirStatement{})
+
+	if err != nil {
+		tests.Failed("Should have successfully packed css file: %+q", err)
+	}
+	tests.Passed("Should have successfully packed css file")
+
+	if len(response) != 2 || response[1].OriginPath != wordanRel+".map" {
+		tests.Failed("Should have successfully received css file and source map")
+	}
+	tests.Passed("Should have successfully received css file and source map")
+
+	var css bytes.Buffer
+	if _, err := response[0].Writer.WriteTo(&css); err != nil {
+		tests.Failed("Should have successfully written css to buffer: %+q", err)
+	}
+
+	if !strings.HasSuffix(css.String(), "\n/*# sourceMappingURL=wordan.css.map */") {
+		tests.Info("Received: %+q", css.String())
+		tests.Failed("Should have successfully referenced source map in css")
+	}
+	tests.Passed("Should have successfully referenced source map in css")
+
+	var srcMap bytes.Buffer
+	if _, err := response[1].Writer.WriteTo(&srcMap); err != nil {
+		tests.Failed("Should have successfully written source map to buffer: %+q", err)
+	}
+
+	var decoded struct {
+		Version  int      `json:"version"`
+		Sources  []string `json:"sources"`
+		Mappings string   `json:"mappings"`
+	}
+
+	if err := json.Unmarshal(srcMap.Bytes(), &decoded); err != nil {
+		tests.Failed("Should have successfully decoded source map: %+q", err)
+	}
+	tests.Passed("Should have successfully decoded source map")
+
+	// The merged rule maps to "html" at 0:0, followed by it's declarations at
+	// 1:4 and 2:4 of the source.
+	expected := "AAAA,sBACI,WACA"
+
+	if decoded.Version != 3 || len(decoded.Sources) != 1 || decoded.Sources[0] != "wordan.css" || decoded.Mappings != expected {
+		tests.Info("Expected: %+q", expected)
+		tests.Info("Received: %+q", decoded.Mappings)
+		tests.Failed("Should have successfully mapped css to source")
+	}
+	tests.Passed("Should have successfully mapped css to source")
+}
@@ -0,0 +1,579 @@
+// +build !js
+
+package packers
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MinifyJS returns the minified version of the javascript source, which has
+// it's comments and whitespace removed, keeping the line breaks which end
+// statements. Comments starting with "/*!", like licenses, are kept.
+func MinifyJS(source string) (string, error) {
+	var writer jsWriter
+	if err := writer.minify(source, 0); err != nil {
+		return "", err
+	}
+
+	return writer.String(), nil
+}
+
+//==============================================================================
+
+// jsKind defines the kind of a javascript token.
+type jsKind int
+
+const (
+	jsPunctuator jsKind = iota
+	jsName
+	jsNumber
+	jsString
+	jsTemplate
+	jsRegexp
+	jsComment
+)
+
+// jsToken defines a token of a javascript source, with it's position in the
+// source, where lines and columns start from zero.
+type jsToken struct {
+	kind    jsKind
+	value   string
+	line    int
+	column  int
+	newline bool
+}
+
+// jsPunctuators defines the punctuators of javascript made of more than one
+// character, longest first.
+var jsPunctuators = []string{
+	">>>=", "...", "===", "!==", "**=", "<<=", ">>=", ">>>", "&&=", "||=", "??=",
+	"=>", "==", "!=", "<=", ">=", "&&", "||", "??", "?.", "++", "--", "+=", "-=",
+	"*=", "/=", "%=", "&=", "|=", "^=", "<<", ">>", "**",
+}
+
+// jsRegexpKeywords defines the keywords after which a "/" starts a regular
+// expression instead of a division.
+var jsRegexpKeywords = map[string]bool{
+	"return": true, "typeof": true, "instanceof": true, "in": true, "of": true,
+	"new": true, "delete": true, "void": true, "throw": true, "case": true,
+	"do": true, "else": true, "yield": true, "await": true,
+}
+
+// jsLexer splits a javascript source into tokens.
+type jsLexer struct {
+	source    string
+	pos       int
+	line      int
+	column    int
+	newline   bool
+	braces    int
+	templates []int
+	tokens    []jsToken
+}
+
+// lexJS returns the tokens of the javascript source, without whitespace and
+// comments except the ones starting with "/*!", and the hashbang of the source
+// if it has one.
+func lexJS(source string) ([]jsToken, string, error) {
+	var hashbang string
+	if strings.HasPrefix(source, "#!") {
+		end := strings.IndexAny(source, "\r\n")
+		if end == -1 {
+			end = len(source)
+		}
+
+		hashbang, source = source[:end], strings.Repeat(" ", end)+source[end:]
+	}
+
+	lexer := jsLexer{source: source}
+	if err := lexer.lex(); err != nil {
+		return nil, "", err
+	}
+
+	return lexer.tokens, hashbang, nil
+}
+
+// lex adds the tokens of the source to the lexer.
+func (l *jsLexer) lex() error {
+	for l.pos < len(l.source) {
+		char, size := utf8.DecodeRuneInString(l.source[l.pos:])
+
+		switch {
+		case char == '\n' || char == '\r' || char == '\u2028' || char == '\u2029':
+			l.newline = true
+			l.advance(size)
+		case char == '\ufeff' || unicode.IsSpace(char):
+			l.advance(size)
+		case strings.HasPrefix(l.source[l.pos:], "//"):
+			end := strings.IndexAny(l.source[l.pos:], "\r\n\u2028\u2029")
+			if end == -1 {
+				end = len(l.source) - l.pos
+			}
+
+			l.advance(end)
+		case strings.HasPrefix(l.source[l.pos:], "/*"):
+			end := strings.Index(l.source[l.pos+2:], "*/")
+			if end == -1 {
+				return l.errorf("Unterminated comment")
+			}
+
+			comment := l.source[l.pos : l.pos+end+4]
+			if strings.HasPrefix(comment, "/*!") {
+				l.emit(jsComment, len(comment))
+				continue
+			}
+
+			if strings.ContainsAny(comment, "\r\n\u2028\u2029") {
+				l.newline = true
+			}
+
+			l.advance(len(comment))
+		case char == '\'' || char == '"':
+			if err := l.lexString(char); err != nil {
+				return err
+			}
+		case char == '`':
+			if err := l.lexTemplate(l.pos); err != nil {
+				return err
+			}
+		case char == '}' && len(l.templates) != 0 && l.templates[len(l.templates)-1] == l.braces:
+			l.templates = l.templates[:len(l.templates)-1]
+
+			if err := l.lexTemplate(l.pos); err != nil {
+				return err
+			}
+		case isDigit(char) || (char == '.' && l.pos+1 < len(l.source) && isDigit(rune(l.source[l.pos+1]))):
+			l.lexNumber()
+		case isNameStart(char) || (char == '#' && l.pos+1 < len(l.source) && isNameStart(rune(l.source[l.pos+1]))):
+			end := l.pos + size
+			for end < len(l.source) {
+				next, nextSize := utf8.DecodeRuneInString(l.source[end:])
+				if !isNamePart(next) {
+					break
+				}
+
+				end += nextSize
+			}
+
+			l.emit(jsName, end-l.pos)
+		case char == '/' && l.regexpAllowed():
+			if err := l.lexRegexp(); err != nil {
+				return err
+			}
+		default:
+			l.lexPunctuator()
+		}
+	}
+
+	return nil
+}
+
+// lexString adds the string starting at the current position.
+func (l *jsLexer) lexString(quote rune) error {
+	for end := l.pos + 1; end < len(l.source); end++ {
+		switch rune(l.source[end]) {
+		case '\\':
+			end++
+		case '\n':
+			return l.errorf("Unterminated string")
+		case quote:
+			l.emit(jsString, end+1-l.pos)
+			return nil
+		}
+	}
+
+	return l.errorf("Unterminated string")
+}
+
+// lexTemplate adds the part of a template literal starting at start, which ends
+// at the end of the template or the start of a substitution.
+func (l *jsLexer) lexTemplate(start int) error {
+	for end := start + 1; end < len(l.source); end++ {
+		switch l.source[end] {
+		case '\\':
+			end++
+		case '`':
+			l.emit(jsTemplate, end+1-l.pos)
+			return nil
+		case '$':
+			if end+1 < len(l.source) && l.source[end+1] == '{' {
+				l.emit(jsTemplate, end+2-l.pos)
+				l.templates = append(l.templates, l.braces)
+				return nil
+			}
+		}
+	}
+
+	return l.errorf("Unterminated template")
+}
+
+// lexNumber adds the number starting at the current position, including the
+// sign of it's exponent.
+func (l *jsLexer) lexNumber() {
+	hex := strings.HasPrefix(strings.ToLower(l.source[l.pos:]), "0x")
+
+	end := l.pos
+	for end < len(l.source) {
+		char := l.source[end]
+
+		switch {
+		case isNamePart(rune(char)) || char == '.':
+		case (char == '+' || char == '-') && !hex && (l.source[end-1] == 'e' || l.source[end-1] == 'E'):
+		default:
+			l.emit(jsNumber, end-l.pos)
+			return
+		}
+
+		end++
+	}
+
+	l.emit(jsNumber, end-l.pos)
+}
+
+// lexRegexp adds the regular expression starting at the current position, with
+// it's flags.
+func (l *jsLexer) lexRegexp() error {
+	var class bool
+
+	for end := l.pos + 1; end < len(l.source); end++ {
+		switch l.source[end] {
+		case '\\':
+			end++
+		case '\n', '\r':
+			return l.errorf("Unterminated regular expression")
+		case '[':
+			class = true
+		case ']':
+			class = false
+		case '/':
+			if class {
+				continue
+			}
+
+			for end++; end < len(l.source) && isNamePart(rune(l.source[end])); end++ {
+			}
+
+			l.emit(jsRegexp, end-l.pos)
+			return nil
+		}
+	}
+
+	return l.errorf("Unterminated regular expression")
+}
+
+// lexPunctuator adds the punctuator at the current position.
+func (l *jsLexer) lexPunctuator() {
+	rest := l.source[l.pos:]
+
+	for _, punctuator := range jsPunctuators {
+		if !strings.HasPrefix(rest, punctuator) {
+			continue
+		}
+
+		// "?." followed by a digit is a conditional with a decimal number.
+		if punctuator == "?." && len(rest) > 2 && isDigit(rune(rest[2])) {
+			break
+		}
+
+		l.emit(jsPunctuator, len(punctuator))
+		return
+	}
+
+	switch rest[0] {
+	case '{':
+		l.braces++
+	case '}':
+		l.braces--
+	}
+
+	_, size := utf8.DecodeRuneInString(rest)
+	l.emit(jsPunctuator, size)
+}
+
+// regexpAllowed returns true if a "/" at the current position starts a regular
+// expression, which depends on the previous token.
+func (l *jsLexer) regexpAllowed() bool {
+	for index := len(l.tokens) - 1; index >= 0; index-- {
+		token := l.tokens[index]
+
+		switch token.kind {
+		case jsComment:
+			continue
+		case jsName:
+			return jsRegexpKeywords[token.value]
+		case jsNumber, jsString, jsRegexp:
+			return false
+		case jsTemplate:
+			return strings.HasSuffix(token.value, "${")
+		}
+
+		switch token.value {
+		case ")", "]", "}", "++", "--":
+			return false
+		default:
+			return true
+		}
+	}
+
+	return true
+}
+
+// emit adds the token of the given size at the current position.
+func (l *jsLexer) emit(kind jsKind, size int) {
+	l.tokens = append(l.tokens, jsToken{
+		kind:    kind,
+		value:   l.source[l.pos : l.pos+size],
+		line:    l.line,
+		column:  l.column,
+		newline: l.newline,
+	})
+
+	// Kept comments leave the line break before them to the next token, which
+	// decides whether it is written.
+	if kind != jsComment {
+		l.newline = false
+	}
+
+	l.advance(size)
+}
+
+// advance moves the current position by size, counting the lines and columns
+// passed, where columns are counted in UTF-16 units as used by source maps.
+func (l *jsLexer) advance(size int) {
+	for _, char := range l.source[l.pos : l.pos+size] {
+		switch {
+		case char == '\n' || char == '\u2028' || char == '\u2029':
+			l.line++
+			l.column = 0
+		case char >= 0x10000:
+			l.column += 2
+		default:
+			l.column++
+		}
+	}
+
+	l.pos += size
+}
+
+// errorf returns an error at the current position.
+func (l *jsLexer) errorf(message string) error {
+	return fmt.Errorf("%s at line %d column %d", message, l.line+1, l.column+1)
+}
+
+// isDigit returns true if the character is a decimal digit.
+func isDigit(char rune) bool {
+	return char >= '0' && char <= '9'
+}
+
+// isNameStart returns true if the character starts an identifier.
+func isNameStart(char rune) bool {
+	return char == '$' || char == '_' || char == '\\' || unicode.IsLetter(char) || (char >= 0x80 && !unicode.IsSpace(char) && char != '\ufeff' && char != '\u2028' && char != '\u2029')
+}
+
+// isNamePart returns true if the character is part of an identifier.
+func isNamePart(char rune) bool {
+	return isNameStart(char) || isDigit(char)
+}
+
+//==============================================================================
+
+// jsContinuations defines the punctuators which continue an expression on the
+// previous line, as no semicolon is inserted before them, where the line break
+// before them can be removed.
+var jsContinuations = map[string]bool{
+	".": true, ",": true, ";": true, ")": true, "]": true, "}": true, ":": true,
+	"(": true, "[": true, "+": true, "-": true, "/": true,
+	"?": true, "?.": true, "=": true, "==": true, "===": true, "!=": true,
+	"!==": true, "<": true, ">": true, "<=": true, ">=": true, "&&": true,
+	"||": true, "??": true, "*": true, "%": true, "**": true, "&": true,
+	"|": true, "^": true, "<<": true, ">>": true, ">>>": true, "+=": true,
+	"-=": true, "*=": true, "/=": true, "%=": true, "**=": true, "<<=": true,
+	">>=": true, ">>>=": true, "&=": true, "|=": true, "^=": true, "&&=": true,
+	"||=": true, "??=": true, "=>": true,
+}
+
+// jsRestricted defines the keywords which end their statement at a line break.
+var jsRestricted = map[string]bool{
+	"return": true, "break": true, "continue": true, "throw": true,
+	"yield": true, "async": true,
+}
+
+// jsWriter writes minified javascript, adding the positions of the names and
+// literals it writes to it's mapper if it has one.
+type jsWriter struct {
+	bytes.Buffer
+	line   int
+	column int
+	mapper *sourceMapper
+
+	// prev contains the last name, literal or punctuator written, and gap
+	// whether a comment written after it separates it from the next token,
+	// with a line break if gap is jsGapLine.
+	prev *jsToken
+	gap  int
+}
+
+const (
+	jsGapNone = iota
+	jsGapSpace
+	jsGapLine
+)
+
+// minify writes the minified source, mapped to the source at the index.
+func (w *jsWriter) minify(source string, index int) error {
+	tokens, hashbang, err := lexJS(source)
+	if err != nil {
+		return err
+	}
+
+	if hashbang != "" && w.Len() == 0 {
+		w.write(hashbang + "\n")
+	}
+
+	for _, token := range tokens {
+		w.token(token, index)
+	}
+
+	return nil
+}
+
+// raw writes the source as is, mapping each of it's lines to the source at the
+// index.
+func (w *jsWriter) raw(source string, index int) {
+	for line := range strings.Split(source, "\n") {
+		if w.mapper != nil {
+			w.mapper.Add(w.line+line, 0, index, line, 0)
+		}
+	}
+
+	w.write(source)
+	w.prev = nil
+}
+
+// separate ends the content written, so the content of another source can be
+// written after it.
+func (w *jsWriter) separate() {
+	if w.Len() == 0 {
+		return
+	}
+
+	if !strings.HasSuffix(strings.TrimRight(w.String(), " \t\r\n"), ";") {
+		w.write(";")
+	}
+
+	w.write("\n")
+	w.prev = nil
+	w.gap = jsGapNone
+}
+
+// token writes the token, with a line break or space before it if needed to
+// keep it apart from the previous token.
+func (w *jsWriter) token(token jsToken, index int) {
+	if token.kind == jsComment {
+		if w.prev != nil && strings.HasSuffix(w.prev.value, "/") {
+			w.write(" ")
+		}
+
+		w.write(token.value)
+
+		if strings.ContainsAny(token.value, "\r\n\u2028\u2029") {
+			w.gap = jsGapLine
+		} else if w.gap == jsGapNone {
+			w.gap = jsGapSpace
+		}
+
+		return
+	}
+
+	if w.prev != nil {
+		switch {
+		case w.gap == jsGapLine:
+		case token.newline && jsNewline(*w.prev, token):
+			w.write("\n")
+		case w.gap == jsGapSpace:
+		case jsSpace(*w.prev, token):
+			w.write(" ")
+		}
+	}
+
+	if w.mapper != nil && token.kind != jsPunctuator {
+		w.mapper.Add(w.line, w.column, index, token.line, token.column)
+	}
+
+	w.write(token.value)
+	w.prev = &token
+	w.gap = jsGapNone
+}
+
+// write writes the text, counting the lines and columns of it in UTF-16 units
+// as used by source maps.
+func (w *jsWriter) write(text string) {
+	w.WriteString(text)
+
+	for _, char := range text {
+		switch {
+		case char == '\n' || char == '\u2028' || char == '\u2029':
+			w.line++
+			w.column = 0
+		case char >= 0x10000:
+			w.column += 2
+		default:
+			w.column++
+		}
+	}
+}
+
+// jsNewline returns true if the line break between the tokens must be kept,
+// as it ends the statement of the previous token.
+func jsNewline(prev jsToken, next jsToken) bool {
+	switch prev.kind {
+	case jsPunctuator:
+		switch prev.value {
+		case ")", "]", "}", "++", "--":
+		default:
+			return false
+		}
+	case jsTemplate:
+		if strings.HasSuffix(prev.value, "${") {
+			return false
+		}
+	case jsName:
+		if jsRestricted[prev.value] {
+			return true
+		}
+	}
+
+	return next.kind != jsPunctuator || !jsContinuations[next.value]
+}
+
+// jsSpace returns true if the tokens need a space between them, so they are
+// not read as a single token or a comment.
+func jsSpace(prev jsToken, next jsToken) bool {
+	last, _ := utf8.DecodeLastRuneInString(prev.value)
+	first, _ := utf8.DecodeRuneInString(next.value)
+
+	switch {
+	case isNamePart(last) && isNamePart(first):
+		return true
+	case prev.kind == jsRegexp && isNamePart(first):
+		return true
+	case prev.kind == jsNumber && first == '.':
+		return true
+	case (last == '+' || last == '-') && first == last:
+		return true
+	case last == '/' && (first == '/' || first == '*'):
+		return true
+	case last == '?' && first == '.':
+		return true
+	case last == '<' && strings.HasPrefix(next.value, "!--"):
+		return true
+	case strings.HasSuffix(prev.value, "--") && first == '>':
+		return true
+	default:
+		return false
+	}
+}
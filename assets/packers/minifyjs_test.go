@@ -0,0 +1,185 @@
+package packers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/assets"
+	"github.com/gu-io/gu/assets/packers"
+	"github.com/influx6/faux/tests"
+)
+
+func TestMinifyJS(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Source   string
+		Expected string
+	}{
+		{
+			Name:     "comments and whitespace",
+			Source:   "// adds numbers\nfunction add ( a, b ) {\n  /* sum */\n  return a + b;\n}\n",
+			Expected: "function add(a,b){return a+b;}",
+		},
+		{
+			Name:     "statements ended by line breaks",
+			Source:   "var a = 1\nvar b = a\n++b\nfoo()\nbar()\n(baz)",
+			Expected: "var a=1\nvar b=a\n++b\nfoo()\nbar()(baz)",
+		},
+		{
+			Name:     "restricted statements",
+			Source:   "function f() {\n  return\n  {\n    a: 1\n  }\n}",
+			Expected: "function f(){return\n{a:1}}",
+		},
+		{
+			Name:     "continued expressions",
+			Source:   "var total = price\n  * count\n  + tax;\nvar name = user\n  .name",
+			Expected: "var total=price*count+tax;var name=user.name",
+		},
+		{
+			Name:     "separated operators",
+			Source:   "a = b + +c - -d; e = f + ++g; h = 1 .toString(); i = x ? .5 : 1",
+			Expected: "a=b+ +c- -d;e=f+ ++g;h=1 .toString();i=x? .5:1",
+		},
+		{
+			Name:     "strings and regular expressions",
+			Source:   "var s = \"a  // b\" + 'c /* d */'; var r = /[/]+ \\/ x/g.test(s); var q = a / b / c",
+			Expected: "var s=\"a  // b\"+'c /* d */';var r=/[/]+ \\/ x/g.test(s);var q=a/b/c",
+		},
+		{
+			Name:     "template literals",
+			Source:   "var t = `a  ${ b + { c: 1 }.c }  d ${ `e ${ f }` }`",
+			Expected: "var t=`a  ${b+{c:1}.c}  d ${`e ${f}`}`",
+		},
+		{
+			Name:     "kept comments",
+			Source:   "/*! license */\nvar a = 1",
+			Expected: "/*! license */var a=1",
+		},
+	}
+
+	for _, item := range cases {
+		minified, err := packers.MinifyJS(item.Source)
+		if err != nil {
+			tests.Failed("Should have successfully minified %s: %+q", item.Name, err)
+		}
+
+		if minified != item.Expected {
+			tests.Info("Expected: %+q", item.Expected)
+			tests.Info("Received: %+q", minified)
+			tests.Failed("Should have successfully minified %s", item.Name)
+		}
+		tests.Passed("Should have successfully minified %s", item.Name)
+	}
+
+	if _, err := packers.MinifyJS("var s = 'unterminated"); err == nil {
+		tests.Failed("Should have failed to minify unterminated string")
+	}
+	tests.Passed("Should have failed to minify unterminated string")
+}
+
+func TestJSPackerBundles(t *testing.T) {
+	fixtures := filepath.Join(thisSrc, "assets/packers/fixtures")
+
+	statements := []assets.FileStatement{
+		{Path: "js/util.js", AbsPath: filepath.Join(fixtures, "js/util.js")},
+		{Path: "js/main.js", AbsPath: filepath.Join(fixtures, "js/main.js")},
+	}
+
+	packer := packers.JSPacker{
+		Minify:    true,
+		SourceMap: true,
+		Manifest:  "bundles.json",
+	}
+
+	response, err := packer.Pack(statements, assets.DirStatement{DirRoot: fixtures})
+	if err != nil {
+		tests.Failed("Should have successfully packed bundle: %+q", err)
+	}
+	tests.Passed("Should have successfully packed bundle")
+
+	if len(response) != 2 {
+		tests.Failed("Should have successfully received only bundle and source map: %d", len(response))
+	}
+	tests.Passed("Should have successfully received only bundle and source map")
+
+	if response[0].OriginPath != "js/app.js" || response[1].OriginPath != "js/app.js.map" {
+		tests.Failed("Should have successfully returned bundle by it's path: %q %q", response[0].OriginPath, response[1].OriginPath)
+	}
+	tests.Passed("Should have successfully returned bundle by it's path")
+
+	bundlePath := regexp.MustCompile(`^js/app\.[0-9a-f]{12}\.js$`)
+	if !bundlePath.MatchString(response[0].Fingerprint) || response[1].Fingerprint != response[0].Fingerprint+".map" {
+		tests.Failed("Should have successfully fingerprinted bundle: %q %q", response[0].Fingerprint, response[1].Fingerprint)
+	}
+	tests.Passed("Should have successfully fingerprinted bundle")
+
+	var bundle bytes.Buffer
+	if _, err := response[0].Writer.WriteTo(&bundle); err != nil {
+		tests.Failed("Should have successfully written bundle to buffer: %+q", err)
+	}
+
+	expected := strings.Join([]string{
+		`/*! util.js | MIT License */function format(template,values){return template.replace(/\{(\w+)\}/g,function(match,name){return values[name]!==undefined?values[name]:match})};`,
+		`var greeting=format("Hello, {name}!",{name:"gu"})`,
+		`console.log(greeting)`,
+		"//# sourceMappingURL=" + filepath.Base(response[0].Fingerprint) + ".map",
+	}, "\n")
+
+	if bundle.String() != expected {
+		tests.Info("Expected: %+q", expected)
+		tests.Info("Received: %+q", bundle.String())
+		tests.Failed("Should have successfully concatenated files in order")
+	}
+	tests.Passed("Should have successfully concatenated files in order")
+
+	hash := assets.ContentHash([]byte(strings.TrimSuffix(expected, "\n//# sourceMappingURL="+filepath.Base(response[0].Fingerprint)+".map")))
+	if response[0].Hash != hash || response[0].Fingerprint != assets.HashedPath("js/app.js", hash) {
+		tests.Failed("Should have successfully fingerprinted bundle with it's content")
+	}
+	tests.Passed("Should have successfully fingerprinted bundle with it's content")
+
+	var srcMap bytes.Buffer
+	if _, err := response[1].Writer.WriteTo(&srcMap); err != nil {
+		tests.Failed("Should have successfully written source map to buffer: %+q", err)
+	}
+
+	var decoded struct {
+		Version  int      `json:"version"`
+		Sources  []string `json:"sources"`
+		Mappings string   `json:"mappings"`
+	}
+
+	if err := json.Unmarshal(srcMap.Bytes(), &decoded); err != nil {
+		tests.Failed("Should have successfully decoded source map: %+q", err)
+	}
+	tests.Passed("Should have successfully decoded source map")
+
+	if len(decoded.Sources) != 2 || decoded.Sources[0] != "util.js" || decoded.Sources[1] != "main.js" {
+		tests.Failed("Should have successfully listed sources of bundle: %+q", decoded.Sources)
+	}
+	tests.Passed("Should have successfully listed sources of bundle")
+
+	// Each of the three lines of the bundle is mapped, starting with "function"
+	// at column 28 mapped to 3:0 of util.js, and "var" at column 0 mapped to
+	// main.js.
+	lines := strings.Split(decoded.Mappings, ";")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "4BAGA,") || !strings.HasPrefix(lines[1], "AC") {
+		tests.Info("Received: %+q", decoded.Mappings)
+		tests.Failed("Should have successfully mapped bundle to sources")
+	}
+	tests.Passed("Should have successfully mapped bundle to sources")
+
+	plain, err := packers.JSPacker{Exceptions: []string{"js/util.js"}}.Pack(statements, assets.DirStatement{DirRoot: fixtures})
+	if err != nil {
+		tests.Failed("Should have successfully packed files: %+q", err)
+	}
+
+	if len(plain) != 1 || plain[0].OriginPath != "js/main.js" {
+		tests.Failed("Should have successfully packed files without bundles as they are")
+	}
+	tests.Passed("Should have successfully packed files without bundles as they are")
+}
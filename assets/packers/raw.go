@@ -19,22 +19,34 @@ func (less RawPacker) Pack(statements []assets.FileStatement, dir assets.DirStat
 	var directives []assets.WriteDirective
 
 	for _, statement := range statements {
-		reader, err := os.Open(statement.AbsPath)
+		packed, err := less.PackFile(statement, dir)
 		if err != nil {
 			return nil, err
 		}
 
-		var bu bytes.Buffer
-		if _, err := io.Copy(&bu, reader); err != nil && err != io.EOF {
-			return nil, err
-		}
-
-		directives = append(directives, assets.WriteDirective{
-			Writer:        &bu,
-			OriginPath:    statement.Path,
-			OriginAbsPath: statement.AbsPath,
-		})
+		directives = append(directives, packed...)
 	}
 
 	return directives, nil
 }
+
+// PackFile returns the WriteDirective of the file with it's content as it is.
+func (less RawPacker) PackFile(statement assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	reader, err := os.Open(statement.AbsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer reader.Close()
+
+	var bu bytes.Buffer
+	if _, err := io.Copy(&bu, reader); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return []assets.WriteDirective{{
+		Writer:        &bu,
+		OriginPath:    statement.Path,
+		OriginAbsPath: statement.AbsPath,
+	}}, nil
+}
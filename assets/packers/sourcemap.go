@@ -0,0 +1,130 @@
+// +build !js
+
+package packers
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// base64VLQ contains the digits of the base64 VLQ encoding used by the
+// mappings of source maps.
+const base64VLQ = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// sourceMap defines the json structure of a version 3 source map.
+type sourceMap struct {
+	Version        int      `json:"version"`
+	File           string   `json:"file"`
+	Sources        []string `json:"sources"`
+	SourcesContent []string `json:"sourcesContent,omitempty"`
+	Names          []string `json:"names"`
+	Mappings       string   `json:"mappings"`
+}
+
+// mapping defines a position of generated content and the position in the
+// source it was generated from, where lines and columns start from zero.
+type mapping struct {
+	genLine   int
+	genColumn int
+	source    int
+	srcLine   int
+	srcColumn int
+}
+
+// sourceMapper collects the mappings of content generated from source files,
+// which are encoded into a source map.
+type sourceMapper struct {
+	mappings []mapping
+}
+
+// Add records that the generated content at genLine and genColumn originates
+// from srcLine and srcColumn of the source at the index.
+func (s *sourceMapper) Add(genLine, genColumn, source, srcLine, srcColumn int) {
+	s.mappings = append(s.mappings, mapping{
+		genLine:   genLine,
+		genColumn: genColumn,
+		source:    source,
+		srcLine:   srcLine,
+		srcColumn: srcColumn,
+	})
+}
+
+// Encode returns the mappings field of the source map, with each generated
+// line separated by ";" and the segments of a line separated by ",".
+func (s *sourceMapper) Encode() string {
+	mappings := make([]mapping, len(s.mappings))
+	copy(mappings, s.mappings)
+
+	sort.SliceStable(mappings, func(i, j int) bool {
+		if mappings[i].genLine != mappings[j].genLine {
+			return mappings[i].genLine < mappings[j].genLine
+		}
+
+		return mappings[i].genColumn < mappings[j].genColumn
+	})
+
+	var bu bytes.Buffer
+	var line, column, source, srcLine, srcColumn int
+
+	for index, item := range mappings {
+		if item.genLine != line {
+			for ; line < item.genLine; line++ {
+				bu.WriteByte(';')
+			}
+
+			column = 0
+		} else if index > 0 {
+			bu.WriteByte(',')
+		}
+
+		writeVLQ(&bu, item.genColumn-column)
+		writeVLQ(&bu, item.source-source)
+		writeVLQ(&bu, item.srcLine-srcLine)
+		writeVLQ(&bu, item.srcColumn-srcColumn)
+
+		column = item.genColumn
+		source = item.source
+		srcLine = item.srcLine
+		srcColumn = item.srcColumn
+	}
+
+	return bu.String()
+}
+
+// Map returns the json of the source map for the generated file, which maps it
+// to the sources with their contents, in the order of their indexes.
+func (s *sourceMapper) Map(file string, sources []string, contents []string) ([]byte, error) {
+	return json.Marshal(sourceMap{
+		Version:        3,
+		File:           file,
+		Sources:        sources,
+		SourcesContent: contents,
+		Names:          []string{},
+		Mappings:       s.Encode(),
+	})
+}
+
+// writeVLQ writes the value in the base64 VLQ encoding, where the lowest bit of
+// the first digit holds the sign and each digit holds 5 bits of the value.
+func writeVLQ(bu *bytes.Buffer, value int) {
+	vlq := value << 1
+	if value < 0 {
+		vlq = (-value << 1) | 1
+	}
+
+	for {
+		digit := vlq & 31
+		vlq >>= 5
+
+		if vlq > 0 {
+			digit |= 32
+		}
+
+		bu.WriteByte(base64VLQ[digit])
+
+		if vlq == 0 {
+			return
+		}
+	}
+}
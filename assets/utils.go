@@ -3,6 +3,8 @@
 package assets
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"io/ioutil"
 	"os"
@@ -32,6 +34,7 @@ type DirStatement struct {
 // retrieved through running the directory.
 func GetDirStatement(dir string, doGo bool) (DirStatement, error) {
 	var statement DirStatement
+	statement.DirRoot = dir
 	statement.FilesByExt = make(map[string][]FileStatement, 0)
 
 	return statement, WalkDir(dir, func(relPath string, absolutePath string, info os.FileInfo) bool {
@@ -67,6 +70,28 @@ func GetDirStatement(dir string, doGo bool) (DirStatement, error) {
 
 //===============================================================================
 
+// Fingerprint returns the path with the content hash of the data added before
+// it's extension, eg. "js/app.js" as "js/app.5d41402abc4b.js", so the content
+// can be cached for as long as it's path remains.
+func Fingerprint(path string, data []byte) string {
+	return HashedPath(path, ContentHash(data))
+}
+
+// ContentHash returns the hex encoded content hash of the data used by
+// Fingerprint, which is the first 6 bytes of it's sha256 sum.
+func ContentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:6])
+}
+
+// HashedPath returns the path with the hash added before it's extension.
+func HashedPath(path string, hash string) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + hash + ext
+}
+
+//===============================================================================
+
 var errStopWalking = errors.New("stop walking directory")
 
 // DirWalker defines a function type which for processing a path and it's info
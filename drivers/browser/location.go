@@ -0,0 +1,215 @@
+// Package browser provides the Location of gu apps running in the browser
+// through gopherjs, which routes with the HTML5 history api or the hash of the
+// page url.
+package browser
+
+import (
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+)
+
+// Location defines a struct which implements the gu.History interface with the
+// history of the browser, pushing the routes of the app with pushState and
+// activating the routes the user moves back and forward to through popstate.
+// The scroll position of the page is saved with each route, and restored when
+// it is visited again.
+type Location struct {
+	app     *gu.NApp
+	mode    router.Mode
+	window  *js.Object
+	history *router.History
+
+	// base contains the position within the history of the browser of the first
+	// route of the history, which is after the routes of previous page loads.
+	base int
+}
+
+// NewLocation returns a new instance of Location for the app, which is set as
+// the app's Location, resolving routes with the mode.
+func NewLocation(app *gu.NApp, mode router.Mode) *Location {
+	l := &Location{
+		app:    app,
+		mode:   mode,
+		window: js.Global.Get("window"),
+	}
+
+	event, _ := l.current()
+	l.history = router.NewHistory(event)
+
+	history := l.window.Get("history")
+	history.Set("scrollRestoration", "manual")
+
+	if index, ok := stateIndex(history.Get("state")); ok {
+		l.base = index
+	} else {
+		history.Call("replaceState", l.state(), "")
+	}
+
+	l.window.Call("addEventListener", "popstate", l.popped)
+
+	app.InitApp(l)
+	return l
+}
+
+// Navigate pushes the route of the directive into the history of the browser, or
+// replaces the current route with it, and activates it on the app.
+func (l *Location) Navigate(pd router.PushDirectiveEvent) {
+	event, err := l.mode.Event(pd.To)
+	if err != nil {
+		return
+	}
+
+	l.saveScroll()
+
+	// Set the location first, so redirects of guards replace it.
+	l.history.Navigate(event, pd)
+
+	method := "pushState"
+	if pd.Replace {
+		method = "replaceState"
+	}
+
+	l.window.Get("history").Call(method, l.state(), "", l.mode.URL(event))
+
+	go l.activate(event, l.Scroll())
+}
+
+// Location returns the current route.
+func (l *Location) Location() router.PushEvent {
+	return l.history.Current().Event
+}
+
+// Go moves the history of the browser by delta, where the route is activated
+// once the browser delivers the popstate event. It returns false if delta is
+// zero.
+func (l *Location) Go(delta int) bool {
+	if delta == 0 {
+		return false
+	}
+
+	l.window.Get("history").Call("go", delta)
+	return true
+}
+
+// Back moves to the previous route.
+func (l *Location) Back() bool {
+	return l.Go(-1)
+}
+
+// Forward moves to the next route.
+func (l *Location) Forward() bool {
+	return l.Go(1)
+}
+
+// Scroll returns the scroll position to restore for the current route.
+func (l *Location) Scroll() router.ScrollPosition {
+	return l.history.Current().Scroll
+}
+
+// SaveScroll records the scroll position of the page on the current route.
+func (l *Location) SaveScroll(position router.ScrollPosition) {
+	l.history.SaveScroll(position)
+	l.window.Get("history").Call("replaceState", l.state(), "")
+}
+
+// popped activates the route the browser moved to, restoring the scroll
+// position saved for it. Routes unknown to the history, which were pushed
+// before the page loaded, start a new history.
+func (l *Location) popped(ev *js.Object) {
+	event, err := l.current()
+	if err != nil {
+		return
+	}
+
+	l.saveScroll()
+
+	index, ok := stateIndex(ev.Get("state"))
+	if !ok {
+		l.history.Push(event)
+		l.window.Get("history").Call("replaceState", l.state(), "")
+	} else if _, ok := l.history.Go(index - l.base - l.history.Index()); !ok {
+		l.base = index
+		l.history = router.NewHistory(event)
+	}
+
+	scroll := l.Scroll()
+	if position, ok := stateScroll(ev.Get("state")); ok {
+		scroll = position
+		l.history.SaveScroll(position)
+	}
+
+	go l.activate(event, scroll)
+}
+
+// activate activates the route on the app and restores the scroll position once
+// the views are active. It is run off the callbacks of the browser, as the
+// loaders of the views block until they finish.
+func (l *Location) activate(event router.PushEvent, scroll router.ScrollPosition) {
+	l.app.ActivateRoute(event)
+	l.scrollTo(scroll)
+}
+
+// current returns the route of the url of the page.
+func (l *Location) current() (router.PushEvent, error) {
+	href := l.window.Get("location").Get("href").String()
+
+	if l.mode == router.HashMode && !strings.Contains(href, "#") {
+		href = href + "#/"
+	}
+
+	return router.NewPushEvent(href, l.mode == router.HashMode)
+}
+
+// state returns the state saved with the current route in the history of the
+// browser.
+func (l *Location) state() js.M {
+	scroll := l.Scroll()
+
+	return js.M{
+		"index":   l.base + l.history.Index(),
+		"scrollX": scroll.X,
+		"scrollY": scroll.Y,
+	}
+}
+
+// saveScroll saves the scroll position of the page on the current route before
+// it is left.
+func (l *Location) saveScroll() {
+	l.history.SaveScroll(router.ScrollPosition{
+		X: l.window.Get("pageXOffset").Float(),
+		Y: l.window.Get("pageYOffset").Float(),
+	})
+}
+
+// scrollTo scrolls the page to the position once the views of the activated
+// route are rendered.
+func (l *Location) scrollTo(position router.ScrollPosition) {
+	l.window.Call("requestAnimationFrame", func() {
+		l.window.Call("scrollTo", position.X, position.Y)
+	})
+}
+
+// stateIndex returns the position within the history of the browser saved in
+// the state of a route, returning false if the route has no state.
+func stateIndex(state *js.Object) (int, bool) {
+	if state == nil || state == js.Undefined || state.Get("index") == js.Undefined {
+		return 0, false
+	}
+
+	return state.Get("index").Int(), true
+}
+
+// stateScroll returns the scroll position saved in the state of a route.
+func stateScroll(state *js.Object) (router.ScrollPosition, bool) {
+	if state == nil || state == js.Undefined || state.Get("scrollY") == js.Undefined {
+		return router.ScrollPosition{}, false
+	}
+
+	return router.ScrollPosition{
+		X: state.Get("scrollX").Float(),
+		Y: state.Get("scrollY").Float(),
+	}, true
+}
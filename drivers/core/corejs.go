@@ -30,7 +30,8 @@ function GuClient(onMessages, SendChannel) {
     GuJS.currentAppID = null;
 
     // GuJS.Dispatch defines a function which dispatches event object to the external
-    // API.
+    // API, where name is the type of the event object (e.g MouseEvent) and meta is
+    // the event's EventJSON.
     GuJS.Dispatch = function(name, model, meta) {
         SendChannel({ "type": name, "meta": meta, "data": model });
     };
@@ -59,7 +60,7 @@ function GuClient(onMessages, SendChannel) {
                     eventObj.stopPropagation()
                 }
 
-                GuJS.Dispatch(GuJS.GetEvent(eventObj), eventMeta)
+                GuJS.Dispatch(GuJS.Type(eventObj), GuJS.GetEvent(eventObj), eventMeta)
             })
         }
     };
@@ -77,6 +78,7 @@ function GuClient(onMessages, SendChannel) {
         switch (co.constructor) {
             case String:
                 command = JSON.parse(co)
+                break
             case Object:
                 command = co
         }
@@ -94,33 +96,11 @@ function GuClient(onMessages, SendChannel) {
                 var app = command.App
                 GuJS.currentAppID = app.AppID
 
-                // Retrieve events map related to the giving app.
-                var appEvents = GuJS.eventsCore[app.AppID] || { views: {}, base: { headEvents: [], bodyEvents: [] } }
-                GuJS.eventsCore[app.AppID] = appEvents
-
                 var nonGuHead = head.querySelectorAll("*:not([data-gen='gu'])")
                 var nonGuBody = head.querySelectorAll("*:not([data-gen='gu'])")
 
-                // Deregister all head base events.
-                GuJS.each(appEvents.base.headEvents, function(cb) {
-                    head.removeEventListener(cb.Event.Event, cb.Callback)
-                })
-
-                // Deregister all body base events.
-                GuJS.each(appEvents.base.bodyEvents, function(cb) {
-                    body.removeEventListener(cb.Event.Event, cb.Callback)
-                })
-
-                // Deregister all view events.
-                GuJS.each(appEvents.views, function(view) {
-                    GuJS.each(view, function(cb) {
-                        body.removeEventListener(cb.Event.Event, cb.Callback)
-                    })
-                })
-
-                appEvents.base.headEvents = [];
-                appEvents.base.bodyEvents = [];
-                appEvents.base.views = {};
+                // Deregister all events of the app.
+                var appEvents = GuJS.resetAppEvents(app.AppID, head, body)
 
                 var headHTML = []
                 var bodyHTML = []
@@ -159,7 +139,7 @@ function GuClient(onMessages, SendChannel) {
                         newEvent.Callback = GuJS.MakeEventCallback(head, event)
 
                         head.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
-                        viewEvents.puhs(newEvent)
+                        viewEvents.push(newEvent)
                     })
 
                     headHTML.push(fragment)
@@ -235,6 +215,46 @@ function GuClient(onMessages, SendChannel) {
 
                 return
 
+            case "HydrateApp":
+                // Hydrating the app adopts the DOM rendered by the server for the
+                // app, instead of replacing it. Nodes are matched by their uid and
+                // only nodes whose hash differ are patched, after which the events
+                // of the app are registered.
+
+                var app = command.App
+                GuJS.currentAppID = app.AppID
+
+                // Deregister all events of the app.
+                var appEvents = GuJS.resetAppEvents(app.AppID, head, body)
+
+                GuJS.each(app.HeadResources, function(item) {
+                    GuJS.PatchDOM(GuJS.createDOMFragment(item.Markup), head, false)
+                    GuJS.registerEvents(head, item.Events, appEvents.base.headEvents)
+                })
+
+                GuJS.each(app.Head, function(item) {
+                    var viewEvents = []
+                    appEvents.views[item.ViewID] = viewEvents
+
+                    GuJS.PatchDOM(GuJS.createDOMFragment(item.Tree.Markup), head, false)
+                    GuJS.registerEvents(head, item.Tree.Events, viewEvents)
+                })
+
+                GuJS.each(app.Body, function(item) {
+                    var viewEvents = []
+                    appEvents.views[item.ViewID] = viewEvents
+
+                    GuJS.PatchDOM(GuJS.createDOMFragment(item.Tree.Markup), body, false)
+                    GuJS.registerEvents(body, item.Tree.Events, viewEvents)
+                })
+
+                GuJS.each(app.BodyResources, function(item) {
+                    GuJS.PatchDOM(GuJS.createDOMFragment(item.Markup), body, false)
+                    GuJS.registerEvents(body, item.Events, appEvents.base.bodyEvents)
+                })
+
+                return
+
             case "RenderView":
                 // Rendering the app response is to clear what is currently in the view.
                 // We want specific replicate the way the gopherjs driver updates apps views
@@ -278,24 +298,114 @@ function GuClient(onMessages, SendChannel) {
 
                 return
 
+            case "PatchView":
+                // Patching the view applies the changes computed for the view
+                // directly into the current DOM, without diffing the view's markup.
+
+                var patch = command.Patch
+
+                // If the view is from a different app then don't service.
+                // An App must be rendered before a view can be patched independently.
+                if (GuJS.currentAppID && patch.AppID !== GuJS.currentAppID) {
+                    return
+                }
+
+                // Retrieve events map related to the giving app.
+                var appEvents = GuJS.eventsCore[patch.AppID] || { views: {}, base: { headEvents: [], bodyEvents: [] } }
+                GuJS.eventsCore[patch.AppID] = appEvents
+
+                // Deregister all view events.
+                GuJS.each(appEvents.views[patch.ViewID] || [], function(cb) {
+                    body.removeEventListener(cb.Event.Event, cb.Callback)
+                })
+
+                var viewEvents = []
+                appEvents.views[patch.ViewID] = viewEvents
+
+                GuJS.ApplyPatches(body, patch.Patches || [])
+
+                // Register all events for this view.
+                GuJS.each(patch.Events || [], function(event) {
+                    var newEvent = {}
+                    newEvent.Event = event
+                    newEvent.Callback = GuJS.MakeEventCallback(body, event)
+
+                    body.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
+                    viewEvents.push(newEvent)
+                })
+
+                return
+
             default:
                 console.log("Command not support: ", command);
         }
     };
 
 
+    // GuJS.resetAppEvents deregisters all events registered for the app from the
+    // head and body, returning the emptied events map of the app.
+    GuJS.resetAppEvents = function(appID, head, body) {
+        var appEvents = GuJS.eventsCore[appID] || { views: {}, base: { headEvents: [], bodyEvents: [] } }
+        GuJS.eventsCore[appID] = appEvents
+
+        // Deregister all head base events.
+        GuJS.each(appEvents.base.headEvents, function(cb) {
+            head.removeEventListener(cb.Event.Event, cb.Callback)
+        })
+
+        // Deregister all body base events.
+        GuJS.each(appEvents.base.bodyEvents, function(cb) {
+            body.removeEventListener(cb.Event.Event, cb.Callback)
+        })
+
+        // Deregister all view events.
+        GuJS.each(appEvents.views, function(view) {
+            GuJS.each(view, function(cb) {
+                head.removeEventListener(cb.Event.Event, cb.Callback)
+                body.removeEventListener(cb.Event.Event, cb.Callback)
+            })
+        })
+
+        appEvents.base.headEvents = [];
+        appEvents.base.bodyEvents = [];
+        appEvents.views = {};
+
+        return appEvents
+    }
+
+    // GuJS.registerEvents registers the events on the target, adding the callbacks
+    // into the provided list.
+    GuJS.registerEvents = function(target, events, list) {
+        GuJS.each(events || [], function(event) {
+            var newEvent = {}
+            newEvent.Event = event
+            newEvent.Callback = GuJS.MakeEventCallback(target, event)
+
+            target.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
+            list.push(newEvent)
+        })
+    }
+
     // GuJS.PatchDOM patches the provided elements into the target from the current DOM.
     // It crawls a liveDOM version of the DOM, removing, replacing and adding node
-    // changes as needed, until the dom resembles it's shadow/fragmentDOM.
-    GuJS.PatchDOM = function(fragmentDOM, liveDOM, replace) {
+    // changes as needed, until the dom resembles it's shadow/fragmentDOM. If ordered
+    // is true, the fragment holds all children of the liveDOM and nodes are kept
+    // at the position of their shadow, else new nodes are appended.
+    GuJS.PatchDOM = function(fragmentDOM, liveDOM, replace, ordered) {
         if (!liveDOM.hasChildNodes()) {
             liveDOM.appendChild(fragmentDOM)
             return
         }
 
-        var shadowNodes = fragmentDOM.childNodes || []
+        // Copy the shadow nodes, has nodes moved into the live DOM are removed
+        // from the fragment's live list.
+        var shadowNodes = Array.prototype.slice.call(fragmentDOM.childNodes || [])
         var liveNodes = liveDOM.childNodes || []
 
+        // elementIndex tracks the position of the current node amongst the element
+        // nodes of the fragment, which is where the node must reside in the live DOM.
+        var elementIndex = 0
+
         for (var index = 0; index < shadowNodes.length; index++) {
             var node = shadowNodes[index]
 
@@ -322,7 +432,7 @@ function GuClient(onMessages, SendChannel) {
             var nodeUID = node.getAttribute("uid")
             var nodeAttr = node.attributes
             var nodeKids = node.childNodes
-            var nodeSel = nodeTagName + "[uid=" + nodeUID + "]"
+            var nodeSel = nodeTagName + "[uid='" + nodeUID + "']"
             var nodeRemoved = node.hasAttribute("NodeRemoved")
             var nodeHash = node.getAttribute("hash")
 
@@ -346,18 +456,34 @@ function GuClient(onMessages, SendChannel) {
 
             var allTargets = liveDOM.querySelectorAll(nodeSel)
             if (!allTargets.length) {
-                liveDOM.appendChild(node)
+                if (!nodeRemoved && ordered) {
+                    GuJS.insertAtPosition(liveDOM, node, elementIndex)
+                    elementIndex++
+                } else if (!nodeRemoved) {
+                    liveDOM.appendChild(node)
+                }
+
                 continue
             }
 
+            if (!nodeRemoved) {
+                elementIndex++
+            }
+
             for (var jindex = 0; jindex < allTargets.length; jindex++) {
                 var curTarget = allTargets[jindex]
 
                 if (nodeRemoved) {
-                    liveDOM.remove(curTarget)
+                    curTarget.parentNode.removeChild(curTarget)
                     continue
                 }
 
+                // Keyed nodes may have been re-ordered, so ensure the node resides
+                // in the same position as it's shadow.
+                if (ordered) {
+                    GuJS.moveToPosition(liveDOM, curTarget, elementIndex - 1)
+                }
+
                 if (replace) {
                     liveDOM.replaceNode(curTarget, node)
                     continue
@@ -389,11 +515,98 @@ function GuClient(onMessages, SendChannel) {
                     continue
                 }
 
-                GuJS.PatchDOM(node, curTarget, replace)
+                GuJS.PatchDOM(node, curTarget, replace, true)
             }
         }
     }
 
+    // GuJS.ApplyPatches applies the list of patches received from a PatchView
+    // command to the nodes found within the provided root.
+    GuJS.ApplyPatches = function(root, patches) {
+        GuJS.each(patches, function(patch) {
+            var target = root.querySelector(patch.Target)
+            if (!target) {
+                return
+            }
+
+            switch (patch.Op) {
+                case "InsertNode":
+                    var fragment = GuJS.createDOMFragment(patch.Markup)
+                    target.insertBefore(fragment, target.childNodes[patch.Index] || null)
+                    return
+
+                case "RemoveNode":
+                    target.parentNode.removeChild(target)
+                    return
+
+                case "MoveNode":
+                    var parent = target.parentNode
+                    if (parent.childNodes[patch.Index] !== target) {
+                        parent.insertBefore(target, parent.childNodes[patch.Index] || null)
+                    }
+                    return
+
+                case "ReplaceNode":
+                    var fragment = GuJS.createDOMFragment(patch.Markup)
+                    target.parentNode.replaceChild(fragment, target)
+                    return
+
+                case "SetAttribute":
+                    target.setAttribute(patch.Name, patch.Value || "")
+                    return
+
+                case "RemoveAttribute":
+                    target.removeAttribute(patch.Name)
+                    return
+
+                case "SetStyle":
+                    target.style.setProperty(patch.Name, patch.Value || "")
+                    return
+
+                case "RemoveStyle":
+                    target.style.removeProperty(patch.Name)
+                    return
+
+                case "SetText":
+                    if (patch.Index < 0) {
+                        target.textContent = patch.Value || ""
+                        return
+                    }
+
+                    var textNode = target.childNodes[patch.Index]
+                    if (textNode) {
+                        textNode.textContent = patch.Value || ""
+                    }
+                    return
+
+                default:
+                    console.log("Patch not supported: ", patch);
+            }
+        })
+    }
+
+    // GuJS.moveToPosition moves the node into the provided position amongst the
+    // element children of the target, if it is a child of the target and not
+    // already at that position.
+    GuJS.moveToPosition = function(target, node, position) {
+        if (node.parentNode !== target) {
+            return
+        }
+
+        var current = target.children[position]
+        if (current === node) {
+            return
+        }
+
+        target.insertBefore(node, current || null)
+    }
+
+    // GuJS.insertAtPosition inserts the node into the provided position amongst
+    // the element children of the target, appending it if no element exists there.
+    GuJS.insertAtPosition = function(target, node, position) {
+        target.insertBefore(node, target.children[position] || null)
+    }
+
     // addIfNoEqual adds a giving node into the target if its not found to match any
     // child nodes of the target and if one is found then that is replaced with the
     // provided new node.
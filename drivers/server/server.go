@@ -0,0 +1,419 @@
+// Package server provides a driver which runs gu apps on the server, rendering
+// the pages of a app and keeping them updated over a websocket connection with
+// the javascript driver core, without need for gopherjs.
+//
+// Each page request whose route activates views of the app creates a session
+// with a new app, whose rendered html is hydrated by the client once it
+// connects. Events from the page are decoded
+// and delivered into the notifications pipeline, while views which publish
+// updates are patched on the page.
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/drivers/core"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+)
+
+// contains the defaults used by the Driver.
+const (
+	// SessionTimeout defines the duration a session waits for it's page to
+	// connect before it is discarded.
+	SessionTimeout = 10 * time.Second
+
+	// MaxMessageSize defines the maximum size of messages received from pages.
+	MaxMessageSize = 1 << 20
+)
+
+// bootstrap defines the script which connects the page to the driver.
+const bootstrap = `(function(){
+	var scheme = window.location.protocol === "https:" ? "wss://" : "ws://";
+	var path = encodeURIComponent(window.location.pathname + window.location.search);
+	var socket = new WebSocket(scheme + window.location.host + %s + "?session=" + %s + "&path=" + path);
+	var listeners = [];
+
+	socket.onmessage = function(message){
+		for(var i = 0; i < listeners.length; i++){
+			listeners[i](message.data);
+		}
+	};
+
+	GuClient(function(listener){
+		listeners.push(listener);
+	}, function(data){
+		socket.send(JSON.stringify(data));
+	});
+})();`
+
+// Driver defines a http.Handler which serves the pages of apps created for
+// each session and the websocket connection which drives them.
+type Driver struct {
+	socketPath string
+	maker      func() *gu.NApp
+
+	ml       sync.Mutex
+	sessions map[string]*session
+}
+
+// New returns a new Driver which creates a app with the maker for each page
+// requested, where the page connects to the driver on the socket path.
+func New(socketPath string, maker func() *gu.NApp) *Driver {
+	return &Driver{
+		socketPath: socketPath,
+		maker:      maker,
+		sessions:   make(map[string]*session),
+	}
+}
+
+// ServeHTTP serves the websocket connection of a page if the request is for the
+// socket path, else serving the page of a new session for the requested path.
+func (d *Driver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == d.socketPath {
+		d.serveSocket(w, r)
+		return
+	}
+
+	d.servePage(w, r)
+}
+
+// servePage renders the page of a new session for the request. Requests whose
+// route activates no views of the app (e.g favicons) are not found, and get no
+// session, as do HEAD requests and requests redirected by guards of the app.
+func (d *Driver) servePage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	pe, err := router.NewPushEvent(r.URL.String(), false)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s := d.newSession(pe)
+
+	html := s.app.Render(pe)
+
+	// Guards redirecting the route navigate the session, which is redirected
+	// to by the browser instead.
+	if redirect, ok := s.redirected(); ok {
+		s.app.Release()
+		http.Redirect(w, r, redirect, http.StatusFound)
+		return
+	}
+
+	if len(s.app.ActiveViews()) == 0 {
+		s.app.Release()
+		http.NotFound(w, r)
+		return
+	}
+
+	if r.Method == "HEAD" {
+		s.app.Release()
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	d.addSession(s)
+
+	socketPath, _ := json.Marshal(d.socketPath)
+	sessionID, _ := json.Marshal(s.id)
+
+	script := trees.NewMarkup("script", false)
+	trees.NewAttr("type", "text/javascript").Apply(script)
+	trees.NewText(bootstrap, socketPath, sessionID).Apply(script)
+
+	if body := trees.Query.Query(html, "body"); body != nil {
+		script.Apply(body)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("<!doctype html>"))
+
+	trees.SimpleElementWriter.WriteTo(w, html)
+
+	// Discard the session if it's page never connects.
+	time.AfterFunc(SessionTimeout, func() {
+		d.ml.Lock()
+		discard := !s.connected
+		if discard {
+			delete(d.sessions, s.id)
+		}
+		d.ml.Unlock()
+
+		if discard {
+			s.app.Release()
+		}
+	})
+}
+
+// serveSocket connects the websocket of a page to it's session. Pages without a
+// session (e.g once it expired) get a new session whose app is rendered with the
+// path of the page, instead of hydrated.
+func (d *Driver) serveSocket(w http.ResponseWriter, r *http.Request) {
+	d.ml.Lock()
+	s, ok := d.sessions[r.URL.Query().Get("session")]
+	if ok && s.connected {
+		ok = false
+	}
+	if ok {
+		s.connected = true
+	}
+	d.ml.Unlock()
+
+	if !ok {
+		path := r.URL.Query().Get("path")
+		if !strings.HasPrefix(path, "/") {
+			path = "/"
+		}
+
+		pe, err := router.NewPushEvent(path, false)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		s = d.newSession(pe)
+		s.navigated = &pe
+		s.connected = true
+
+		d.addSession(s)
+	}
+
+	defer func() {
+		d.ml.Lock()
+		delete(d.sessions, s.id)
+		d.ml.Unlock()
+
+		s.app.Release()
+	}()
+
+	conn, err := upgrade(w, r, MaxMessageSize)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.run(conn)
+}
+
+// newSession returns a new session with a new app for the route.
+func (d *Driver) newSession(pe router.PushEvent) *session {
+	s := &session{
+		id:      gu.NewKey(),
+		current: pe,
+		app:     d.maker(),
+		pending: make(map[string]*gu.NView),
+		signal:  make(chan struct{}, 1),
+	}
+
+	s.app.InitApp(s)
+
+	return s
+}
+
+// addSession adds the session to the sessions of the driver.
+func (d *Driver) addSession(s *session) {
+	d.ml.Lock()
+	d.sessions[s.id] = s
+	d.ml.Unlock()
+}
+
+//==============================================================================
+
+// eventMessage defines the message sent by the javascript driver core for each
+// event, see GuJS.Dispatch.
+type eventMessage struct {
+	Type string          `json:"type"`
+	Meta trees.EventJSON `json:"meta"`
+	Data json.RawMessage `json:"data"`
+}
+
+// session defines the app of a single page, which implements the gu.Location
+// for the app.
+type session struct {
+	id        string
+	app       *gu.NApp
+	connected bool
+
+	// al guards the app against rendering while events are delivered.
+	al sync.Mutex
+
+	ml        sync.Mutex
+	current   router.PushEvent
+	navigated *router.PushEvent
+	pending   map[string]*gu.NView
+	signal    chan struct{}
+}
+
+// Location returns the current route of the session.
+func (s *session) Location() router.PushEvent {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+	return s.current
+}
+
+// Navigate queues the route of the directive to be rendered on the page.
+func (s *session) Navigate(pd router.PushDirectiveEvent) {
+	pe, err := router.NewPushEvent(pd.To, false)
+	if err != nil {
+		return
+	}
+
+	s.ml.Lock()
+	s.current = pe
+	s.navigated = &pe
+	s.ml.Unlock()
+
+	s.notify()
+}
+
+// redirected returns the url of the route the session was navigated to before
+// connecting to it's page, if any.
+func (s *session) redirected() (string, bool) {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	if s.navigated == nil {
+		return "", false
+	}
+
+	return s.navigated.From, true
+}
+
+// notify signals the writer of the session that there are changes to send.
+func (s *session) notify() {
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+// run drives the page over the socket till it is closed. The page is hydrated
+// unless the session was navigated before connecting, where it is rendered.
+func (s *session) run(conn *socket) {
+	defer conn.Close()
+
+	remover := s.app.Notifications().SubscribeWithRemover(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		// Views are rendered by the writer, batching the updates published
+		// while it sends previous changes.
+		s.ml.Lock()
+		s.pending[update.View.UUID()] = update.View
+		s.ml.Unlock()
+
+		s.notify()
+	}))
+
+	defer remover.Remove()
+
+	s.ml.Lock()
+	navigated := s.navigated
+	s.navigated = nil
+	s.ml.Unlock()
+
+	s.al.Lock()
+	var command gu.RenderCommand
+	if navigated != nil {
+		command = gu.AppRenderCommand(s.app, *navigated)
+	} else {
+		command = gu.AppHydrateCommand(s.app, nil)
+	}
+	s.al.Unlock()
+
+	if err := s.send(conn, command); err != nil {
+		return
+	}
+
+	s.app.Mounted()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go s.write(conn, done)
+
+	for {
+		data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var message eventMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			continue
+		}
+
+		event, err := core.GetEvent(message.Type, message.Data, nil)
+		if err != nil {
+			continue
+		}
+
+		s.al.Lock()
+		s.app.Notifications().Dispatch(common.EventBroadcast{
+			EventName: message.Meta.EventName,
+			EventID:   message.Meta.EventID,
+			Event:     event,
+		})
+		s.al.Unlock()
+	}
+}
+
+// write sends the changes of the app to the page as they are signaled, till
+// done is closed.
+func (s *session) write(conn *socket, done chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-s.signal:
+		}
+
+		s.ml.Lock()
+		navigated := s.navigated
+		pending := s.pending
+		s.navigated = nil
+		s.pending = make(map[string]*gu.NView)
+		s.ml.Unlock()
+
+		var commands []gu.RenderCommand
+
+		s.al.Lock()
+		if navigated != nil {
+			commands = append(commands, gu.AppRenderCommand(s.app, *navigated))
+		} else {
+			for _, view := range s.app.ActiveViews() {
+				if _, ok := pending[view.UUID()]; ok {
+					commands = append(commands, gu.ViewPatchCommand(view))
+				}
+			}
+		}
+		s.al.Unlock()
+
+		for _, command := range commands {
+			if err := s.send(conn, command); err != nil {
+				conn.Close()
+				return
+			}
+		}
+	}
+}
+
+// send writes the command to the page as json.
+func (s *session) send(conn *socket, command gu.RenderCommand) error {
+	data, err := json.Marshal(command)
+	if err != nil {
+		return err
+	}
+
+	return conn.WriteText(data)
+}
@@ -0,0 +1,383 @@
+package server_test
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/server"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+)
+
+var success = "✓"
+var failed = "✗"
+
+type page struct{}
+
+func (page) Render() *trees.Markup {
+	return elems.Div(trees.NewAttr("class", "page"), elems.Header(elems.Text("Counter")))
+}
+
+type counter struct {
+	gu.Reactive
+	count   int
+	clicked chan struct{}
+}
+
+func (c *counter) Render() *trees.Markup {
+	return elems.Button(
+		trees.NewAttr("class", "add"),
+		elems.Text("%d", c.count),
+		events.ClickEvent(func() {
+			c.count++
+			c.clicked <- struct{}{}
+		}),
+	)
+}
+
+type label string
+
+func (l label) Render() *trees.Markup {
+	return elems.Span(trees.NewAttr("class", string(l)))
+}
+
+var uidMatch = regexp.MustCompile(`uid="([^"]+)"`)
+
+var sessionMatch = regexp.MustCompile(`"\?session=" \+ "([^"]+)"`)
+
+func TestDriver(t *testing.T) {
+	count := &counter{Reactive: gu.NewReactive(), clicked: make(chan struct{}, 1)}
+
+	driver := server.New("/socket", func() *gu.NApp {
+		app := gu.App("Counter", nil)
+		view := app.View(page{}, "*", gu.BodyTarget)
+		view.Component(count, gu.AnyOrder, "", "")
+		return app
+	})
+
+	ts := httptest.NewServer(driver)
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have requested page: %q", failed, err.Error())
+	}
+
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	html := string(body)
+	if !strings.HasPrefix(html, "<!doctype html>") || !strings.Contains(html, "GuClient(") || !strings.Contains(html, `class="add"`) {
+		t.Fatalf("\t%s\t Should have rendered page with bootstrap script: %q", failed, html)
+	}
+	t.Logf("\t%s\t Should have rendered page with bootstrap script", success)
+
+	matches := sessionMatch.FindStringSubmatch(html)
+	if matches == nil {
+		t.Fatalf("\t%s\t Should have rendered session id into page", failed)
+	}
+	t.Logf("\t%s\t Should have rendered session id into page", success)
+
+	conn, reader := dial(t, ts.URL, "/socket?session="+matches[1])
+	defer conn.Close()
+
+	var command gu.RenderCommand
+	readCommand(t, reader, &command)
+
+	if command.Command != "HydrateApp" || len(command.App.Body) == 0 {
+		t.Fatalf("\t%s\t Should have received HydrateApp command: %q", failed, command.Command)
+	}
+	t.Logf("\t%s\t Should have received HydrateApp command", success)
+
+	// The page adopts the nodes matching the uids of the command, so no nodes
+	// are added to the page once hydrated.
+	for _, view := range command.App.Body {
+		uids := uidMatch.FindAllStringSubmatch(view.Tree.Markup, -1)
+		if len(uids) < 2 {
+			t.Fatalf("\t%s\t Should have received nested markup of view: %q", failed, view.Tree.Markup)
+		}
+
+		for _, uid := range uids {
+			if !strings.Contains(html, uid[0]) {
+				t.Fatalf("\t%s\t Should have received markup matching nodes of page: %q", failed, uid[1])
+			}
+		}
+	}
+	t.Logf("\t%s\t Should have received markup matching nodes of page", success)
+
+	var click *trees.EventJSON
+	for _, view := range command.App.Body {
+		for index, event := range view.Tree.Events {
+			if event.Event == "click" {
+				click = &view.Tree.Events[index]
+			}
+		}
+	}
+
+	if click == nil || click.EventID == "" {
+		t.Fatalf("\t%s\t Should have received click event of view", failed)
+	}
+	t.Logf("\t%s\t Should have received click event of view", success)
+
+	message, _ := json.Marshal(map[string]interface{}{
+		"type": "MouseEvent",
+		"meta": click,
+		"data": map[string]interface{}{"Detail": 1},
+	})
+
+	writeFrame(t, conn, message)
+
+	select {
+	case <-count.clicked:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("\t%s\t Should have delivered click event to component", failed)
+	}
+	t.Logf("\t%s\t Should have delivered click event to component", success)
+
+	count.Publish()
+
+	var patch gu.RenderCommand
+	readCommand(t, reader, &patch)
+
+	var updated bool
+	for _, change := range patch.Patch.Patches {
+		if change.Value == "1" || strings.Contains(change.Markup, ">1<") {
+			updated = true
+		}
+	}
+
+	if patch.Command != "PatchView" || !updated {
+		t.Fatalf("\t%s\t Should have received PatchView command with update: %+v", failed, patch)
+	}
+	t.Logf("\t%s\t Should have received PatchView command with update", success)
+}
+
+func TestDriverRejectsBadOrigin(t *testing.T) {
+	ts := httptest.NewServer(server.New("/socket", func() *gu.NApp {
+		return gu.App("Empty", nil)
+	}))
+	defer ts.Close()
+
+	req, _ := http.NewRequest("GET", ts.URL+"/socket", nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Origin", "http://example.com")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("\t%s\t Should have requested socket: %q", failed, err.Error())
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("\t%s\t Should have rejected foreign origin: %d", failed, res.StatusCode)
+	}
+	t.Logf("\t%s\t Should have rejected foreign origin", success)
+}
+
+func TestDriverServesOnlyPages(t *testing.T) {
+	ts := httptest.NewServer(server.New("/socket", func() *gu.NApp {
+		app := gu.App("Home", nil)
+		app.View(page{}, "/home/*", gu.BodyTarget)
+		return app
+	}))
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/favicon.ico")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have requested favicon: %q", failed, err.Error())
+	}
+
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusNotFound || sessionMatch.Match(body) {
+		t.Fatalf("\t%s\t Should have not found route without views: %d", failed, res.StatusCode)
+	}
+	t.Logf("\t%s\t Should have not found route without views", success)
+
+	res, err = http.Get(ts.URL + "/home")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have requested page: %q", failed, err.Error())
+	}
+
+	body, _ = ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || !sessionMatch.Match(body) {
+		t.Fatalf("\t%s\t Should have rendered page of route with views: %d", failed, res.StatusCode)
+	}
+	t.Logf("\t%s\t Should have rendered page of route with views", success)
+}
+
+func TestDriverRendersPathOfExpiredSession(t *testing.T) {
+	ts := httptest.NewServer(server.New("/socket", func() *gu.NApp {
+		app := gu.App("Pages", nil)
+		app.View(label("home"), "/home/*", gu.BodyTarget)
+		app.View(label("about"), "/about/*", gu.BodyTarget)
+		return app
+	}))
+	defer ts.Close()
+
+	conn, reader := dial(t, ts.URL, "/socket?session=expired&path=%2Fabout")
+	defer conn.Close()
+
+	var command gu.RenderCommand
+	readCommand(t, reader, &command)
+
+	if command.Command != "RenderApp" || len(command.App.Body) != 1 || !strings.Contains(command.App.Body[0].Tree.Markup, "about") {
+		t.Fatalf("\t%s\t Should have rendered path of page without session: %+v", failed, command.App.Body)
+	}
+	t.Logf("\t%s\t Should have rendered path of page without session", success)
+}
+
+func TestDriverRedirectsGuardedPage(t *testing.T) {
+	ts := httptest.NewServer(server.New("/socket", func() *gu.NApp {
+		app := gu.App("Guards", nil)
+		app.View(label("login"), "/login/*", gu.BodyTarget)
+		app.View(label("admin"), "/admin/*", gu.BodyTarget).Guard(func(router.PushEvent) (bool, string) {
+			return false, "/login"
+		})
+		return app
+	}))
+	defer ts.Close()
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := client.Get(ts.URL + "/admin")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have requested page: %q", failed, err.Error())
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusFound || res.Header.Get("Location") != "/login" {
+		t.Fatalf("\t%s\t Should have redirected page blocked by guard: %d %q", failed, res.StatusCode, res.Header.Get("Location"))
+	}
+	t.Logf("\t%s\t Should have redirected page blocked by guard", success)
+
+	res, err = http.Get(ts.URL + "/admin")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have requested page: %q", failed, err.Error())
+	}
+
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || !strings.Contains(string(body), `class="login"`) {
+		t.Fatalf("\t%s\t Should have rendered page redirected to: %d", failed, res.StatusCode)
+	}
+	t.Logf("\t%s\t Should have rendered page redirected to", success)
+}
+
+// dial connects a websocket to the path on the server.
+func dial(t *testing.T, serverURL string, path string) (net.Conn, *bufio.Reader) {
+	host := strings.TrimPrefix(serverURL, "http://")
+
+	conn, err := net.Dial("tcp", host)
+	if err != nil {
+		t.Fatalf("\t%s\t Should have connected to server: %q", failed, err.Error())
+	}
+
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	io.WriteString(conn, "GET "+path+" HTTP/1.1\r\n"+
+		"Host: "+host+"\r\n"+
+		"Origin: "+serverURL+"\r\n"+
+		"Connection: Upgrade\r\n"+
+		"Upgrade: websocket\r\n"+
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n"+
+		"Sec-WebSocket-Version: 13\r\n\r\n")
+
+	reader := bufio.NewReader(conn)
+
+	res, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		t.Fatalf("\t%s\t Should have read handshake response: %q", failed, err.Error())
+	}
+
+	if res.StatusCode != http.StatusSwitchingProtocols || res.Header.Get("Sec-WebSocket-Accept") != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Fatalf("\t%s\t Should have upgraded connection: %d", failed, res.StatusCode)
+	}
+	t.Logf("\t%s\t Should have upgraded connection", success)
+
+	return conn, reader
+}
+
+// readCommand reads the next text frame sent by the server into the command.
+func readCommand(t *testing.T, reader *bufio.Reader, command *gu.RenderCommand) {
+	var header [2]byte
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		t.Fatalf("\t%s\t Should have read frame: %q", failed, err.Error())
+	}
+
+	if header[0] != 0x81 {
+		t.Fatalf("\t%s\t Should have read final text frame: %x", failed, header[0])
+	}
+
+	size := uint64(header[1] & 0x7F)
+
+	switch size {
+	case 126:
+		var ext [2]byte
+		io.ReadFull(reader, ext[:])
+		size = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		io.ReadFull(reader, ext[:])
+		size = binary.BigEndian.Uint64(ext[:])
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		t.Fatalf("\t%s\t Should have read frame payload: %q", failed, err.Error())
+	}
+
+	if err := json.Unmarshal(payload, command); err != nil {
+		t.Fatalf("\t%s\t Should have decoded command: %q", failed, err.Error())
+	}
+}
+
+// writeFrame writes the data as a masked text frame.
+func writeFrame(t *testing.T, conn net.Conn, data []byte) {
+	frame := []byte{0x81}
+
+	switch {
+	case len(data) <= 125:
+		frame = append(frame, 0x80|byte(len(data)))
+	default:
+		var ext [2]byte
+		binary.BigEndian.PutUint16(ext[:], uint16(len(data)))
+		frame = append(frame, 0x80|126)
+		frame = append(frame, ext[:]...)
+	}
+
+	mask := [4]byte{0x12, 0x34, 0x56, 0x78}
+	frame = append(frame, mask[:]...)
+
+	for index, b := range data {
+		frame = append(frame, b^mask[index%4])
+	}
+
+	if _, err := conn.Write(frame); err != nil {
+		t.Fatalf("\t%s\t Should have written frame: %q", failed, err.Error())
+	}
+}
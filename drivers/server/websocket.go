@@ -0,0 +1,278 @@
+package server
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// websocketGUID defines the guid used in computing the accept key of a
+// websocket handshake, as defined by RFC 6455.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// contains the websocket frame opcodes.
+const (
+	continuationFrame = 0x0
+	textFrame         = 0x1
+	binaryFrame       = 0x2
+	closeFrame        = 0x8
+	pingFrame         = 0x9
+	pongFrame         = 0xA
+)
+
+// ErrNotWebsocket is returned when a request is not a valid websocket handshake.
+var ErrNotWebsocket = errors.New("Request is not a websocket handshake")
+
+// ErrBadOrigin is returned when the origin of a websocket handshake does not
+// match the host of the request.
+var ErrBadOrigin = errors.New("Websocket origin does not match host")
+
+// ErrProtocol is returned when a websocket frame violates the protocol.
+var ErrProtocol = errors.New("Websocket protocol error")
+
+// ErrMessageTooLarge is returned when a websocket message is larger than the
+// maximum size allowed.
+var ErrMessageTooLarge = errors.New("Websocket message too large")
+
+// socket defines a server side websocket connection, implementing the parts
+// of RFC 6455 needed by the driver.
+type socket struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	maxSize int64
+
+	wl     sync.Mutex
+	writer *bufio.Writer
+}
+
+// upgrade validates the websocket handshake of the request, taking over it's
+// connection and returning the socket for it.
+func upgrade(w http.ResponseWriter, r *http.Request, maxSize int64) (*socket, error) {
+	if r.Method != "GET" || !headerHas(r.Header, "Connection", "upgrade") || !headerHas(r.Header, "Upgrade", "websocket") {
+		return nil, ErrNotWebsocket
+	}
+
+	key := r.Header.Get("Sec-Websocket-Key")
+	if key == "" || r.Header.Get("Sec-Websocket-Version") != "13" {
+		return nil, ErrNotWebsocket
+	}
+
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if u, err := url.Parse(origin); err != nil || !strings.EqualFold(u.Host, r.Host) {
+			return nil, ErrBadOrigin
+		}
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, ErrNotWebsocket
+	}
+
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha1.Sum([]byte(key + websocketGUID))
+
+	rw.WriteString("HTTP/1.1 101 Switching Protocols\r\n")
+	rw.WriteString("Upgrade: websocket\r\n")
+	rw.WriteString("Connection: Upgrade\r\n")
+	rw.WriteString("Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(hash[:]) + "\r\n\r\n")
+
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &socket{
+		conn:    conn,
+		reader:  rw.Reader,
+		writer:  rw.Writer,
+		maxSize: maxSize,
+	}, nil
+}
+
+// ReadMessage returns the data of the next text or binary message received,
+// answering pings and joining fragmented messages. It returns io.EOF when the
+// connection is closed by the client.
+func (s *socket) ReadMessage() ([]byte, error) {
+	var message []byte
+	var started bool
+
+	for {
+		fin, opcode, payload, err := s.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case pingFrame:
+			if err := s.writeFrame(pongFrame, payload); err != nil {
+				return nil, err
+			}
+
+			continue
+
+		case pongFrame:
+			continue
+
+		case closeFrame:
+			// Echo the status code of the client before closing.
+			if len(payload) >= 2 {
+				payload = payload[:2]
+			}
+
+			s.writeFrame(closeFrame, payload)
+			return nil, io.EOF
+
+		case textFrame, binaryFrame:
+			if started {
+				return nil, ErrProtocol
+			}
+
+			started = true
+
+		case continuationFrame:
+			if !started {
+				return nil, ErrProtocol
+			}
+
+		default:
+			return nil, ErrProtocol
+		}
+
+		if int64(len(message)+len(payload)) > s.maxSize {
+			return nil, ErrMessageTooLarge
+		}
+
+		message = append(message, payload...)
+
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+// readFrame reads a single frame from the connection, unmasking it's payload.
+func (s *socket) readFrame() (bool, byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(s.reader, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	fin := header[0]&0x80 != 0
+	opcode := header[0] & 0x0F
+
+	// Extensions are never negotiated, so reserved bits must be unset and
+	// clients must mask all frames.
+	if header[0]&0x70 != 0 || header[1]&0x80 == 0 {
+		return false, 0, nil, ErrProtocol
+	}
+
+	size := int64(header[1] & 0x7F)
+
+	switch size {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(s.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+
+		size = int64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(s.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+
+		size = int64(binary.BigEndian.Uint64(ext[:]))
+	}
+
+	// Control frames must not be fragmented and are limited to 125 bytes.
+	if opcode >= closeFrame && (!fin || size > 125) {
+		return false, 0, nil, ErrProtocol
+	}
+
+	if size < 0 || size > s.maxSize {
+		return false, 0, nil, ErrMessageTooLarge
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(s.reader, mask[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(s.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+
+	for index := range payload {
+		payload[index] ^= mask[index%4]
+	}
+
+	return fin, opcode, payload, nil
+}
+
+// WriteText writes the data as a single text message.
+func (s *socket) WriteText(data []byte) error {
+	return s.writeFrame(textFrame, data)
+}
+
+// writeFrame writes a single unmasked frame with the opcode and payload.
+func (s *socket) writeFrame(opcode byte, payload []byte) error {
+	s.wl.Lock()
+	defer s.wl.Unlock()
+
+	s.writer.WriteByte(0x80 | opcode)
+
+	size := len(payload)
+
+	switch {
+	case size <= 125:
+		s.writer.WriteByte(byte(size))
+	case size <= 0xFFFF:
+		var ext [2]byte
+		binary.BigEndian.PutUint16(ext[:], uint16(size))
+		s.writer.WriteByte(126)
+		s.writer.Write(ext[:])
+	default:
+		var ext [8]byte
+		binary.BigEndian.PutUint64(ext[:], uint64(size))
+		s.writer.WriteByte(127)
+		s.writer.Write(ext[:])
+	}
+
+	s.writer.Write(payload)
+
+	return s.writer.Flush()
+}
+
+// Close sends a close frame and closes the connection.
+func (s *socket) Close() error {
+	s.writeFrame(closeFrame, []byte{0x03, 0xE8})
+	return s.conn.Close()
+}
+
+// headerHas returns true/false if the comma separated values of the header
+// contains the value, ignoring case.
+func headerHas(header http.Header, name string, value string) bool {
+	for _, line := range header[http.CanonicalHeaderKey(name)] {
+		for _, item := range strings.Split(line, ",") {
+			if strings.EqualFold(strings.TrimSpace(item), value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,385 @@
+// Package testdriver provides a headless driver which mounts a gu app without a
+// browser, allowing apps and components to be driven from plain go tests.
+package testdriver
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+)
+
+// ErrNotFound is returned when no markup matches a selector.
+var ErrNotFound = errors.New("No markup matches selector")
+
+// ErrNoEvent is returned when the markup has no event of the triggered type.
+var ErrNoEvent = errors.New("Markup has no event of type")
+
+// Driver defines a headless driver which renders the views of a app into markup,
+// keeping the live markup of each view updated when the view publishes changes.
+// Updated views are rendered outside of the delivery of their ViewUpdate, before
+// the driver next accesses the markup of the views. Driver implements the
+// gu.History interface, so navigation within the app re-renders the matching
+// views, with the history of the routes kept as browsers do.
+type Driver struct {
+	app     *gu.NApp
+	remover common.Remover
+
+	ml         sync.Mutex
+	activation int
+	history    *router.History
+	current    router.PushEvent
+	views      []*gu.NView
+	live       map[string]*trees.Markup
+	pending    map[string]*gu.NView
+}
+
+// New returns a new Driver for the app, which is set as the app's Location.
+func New(app *gu.NApp) *Driver {
+	root, _ := router.NewPushEvent("/#", true)
+
+	d := &Driver{
+		app:     app,
+		history: router.NewHistory(root),
+		live:    make(map[string]*trees.Markup),
+		pending: make(map[string]*gu.NView),
+	}
+
+	app.InitApp(d)
+
+	d.remover = d.app.Notifications().SubscribeWithRemover(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		d.ml.Lock()
+		defer d.ml.Unlock()
+
+		if _, active := d.live[update.View.UUID()]; active {
+			d.pending[update.View.UUID()] = update.View
+		}
+	}))
+
+	return d
+}
+
+// Close stops the driver from receiving view updates.
+func (d *Driver) Close() {
+	d.remover.Remove()
+}
+
+// Mount activates the route on the app, renders the matching views and
+// notifies them that they are mounted. The route starts a new history.
+func (d *Driver) Mount(route string) error {
+	pe, err := router.NewPushEvent(route, true)
+	if err != nil {
+		return err
+	}
+
+	d.ml.Lock()
+	d.history = router.NewHistory(pe)
+	d.ml.Unlock()
+
+	d.activate(pe)
+	d.app.Mounted()
+
+	return nil
+}
+
+// Navigate activates the route of the directive on the app, rendering the views
+// which match it. The route is pushed into the history, or replaces it's
+// current route if the directive says so.
+func (d *Driver) Navigate(pd router.PushDirectiveEvent) {
+	pe, err := router.NewPushEvent(pd.To, true)
+	if err != nil {
+		return
+	}
+
+	d.getHistory().Navigate(pe, pd)
+	d.activate(pe)
+}
+
+// Go moves the current route of the history by delta, activating it on the app.
+func (d *Driver) Go(delta int) bool {
+	entry, ok := d.getHistory().Go(delta)
+	if !ok {
+		return false
+	}
+
+	d.activate(entry.Event)
+	return true
+}
+
+// Back moves to the previous route of the history.
+func (d *Driver) Back() bool {
+	return d.Go(-1)
+}
+
+// Forward moves to the next route of the history.
+func (d *Driver) Forward() bool {
+	return d.Go(1)
+}
+
+// Scroll returns the scroll position saved for the current route.
+func (d *Driver) Scroll() router.ScrollPosition {
+	return d.getHistory().Current().Scroll
+}
+
+// SaveScroll records the scroll position of the current route.
+func (d *Driver) SaveScroll(position router.ScrollPosition) {
+	d.getHistory().SaveScroll(position)
+}
+
+// getHistory returns the history of the routes of the driver.
+func (d *Driver) getHistory() *router.History {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+	return d.history
+}
+
+// Location returns the route last activated through the driver.
+func (d *Driver) Location() router.PushEvent {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+	return d.current
+}
+
+// activate activates the route on the app and renders the active views.
+// A route redirected by guards of the views is left to the activation of the
+// redirect.
+func (d *Driver) activate(pe router.PushEvent) {
+	d.ml.Lock()
+	d.activation++
+	activation := d.activation
+	d.current = pe
+	d.ml.Unlock()
+
+	d.app.ActivateRoute(pe)
+
+	views := d.app.ActiveViews()
+
+	live := make(map[string]*trees.Markup)
+	for _, view := range views {
+		live[view.UUID()] = view.Render()
+	}
+
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	if activation != d.activation {
+		return
+	}
+
+	d.views = views
+	d.live = live
+	d.pending = make(map[string]*gu.NView)
+}
+
+// update renders the views which published updates since the last update,
+// replacing their live markup.
+func (d *Driver) update() {
+	for {
+		d.ml.Lock()
+		pending := d.pending
+		d.pending = make(map[string]*gu.NView)
+		d.ml.Unlock()
+
+		if len(pending) == 0 {
+			return
+		}
+
+		for id, view := range pending {
+			tree := view.Render()
+
+			d.ml.Lock()
+			d.live[id] = tree
+			d.ml.Unlock()
+
+			view.Updated()
+		}
+	}
+}
+
+// View returns the live markup of the view, it returns nil if the view is not
+// active.
+func (d *Driver) View(view *gu.NView) *trees.Markup {
+	d.update()
+
+	d.ml.Lock()
+	defer d.ml.Unlock()
+	return d.live[view.UUID()]
+}
+
+// Views returns the live markup of all active views in the order of the views.
+func (d *Driver) Views() []*trees.Markup {
+	d.update()
+
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	var views []*trees.Markup
+	for _, view := range d.views {
+		views = append(views, d.live[view.UUID()])
+	}
+
+	return views
+}
+
+// HTML returns the html of all active views.
+func (d *Driver) HTML() string {
+	var html string
+
+	for _, view := range d.Views() {
+		html += view.HTML()
+	}
+
+	return html
+}
+
+// Query returns the first markup within the active views which matches the
+// selector, it returns nil if none matches.
+func (d *Driver) Query(selector string) *trees.Markup {
+	if found := d.QueryAll(selector); len(found) != 0 {
+		return found[0]
+	}
+
+	return nil
+}
+
+// QueryAll returns all markup within the active views which match the selector.
+// Markup removed from the views by reconciliation is never returned.
+func (d *Driver) QueryAll(selector string) []*trees.Markup {
+	var found []*trees.Markup
+
+	for _, view := range d.Views() {
+		found = append(found, queryView(view, selector)...)
+	}
+
+	return found
+}
+
+// Click dispatches a click event to the first markup matching the selector.
+func (d *Driver) Click(selector string) error {
+	return d.Trigger(selector, "click", &eventx.MouseEvent{
+		UIEvent: &eventx.UIEvent{Detail: 1},
+	})
+}
+
+// Input sets the value attribute of the first markup matching the selector and
+// dispatches a input event with the value to it.
+func (d *Driver) Input(selector string, value string) error {
+	target := d.Query(selector)
+	if target == nil {
+		return fmt.Errorf("%s: %q", ErrNotFound, selector)
+	}
+
+	if _, err := trees.GetAttr(target, "value"); err != nil {
+		trees.NewAttr("value", value).Apply(target)
+	} else {
+		trees.ReplaceAttribute(target, "value", value)
+	}
+
+	return d.trigger(target, "input", &eventx.InputEvent{Data: value})
+}
+
+// Submit dispatches a submit event to the first markup matching the selector.
+// Like the javascript driver, submit events are delivered as a
+// eventx.BasicEventMap.
+func (d *Driver) Submit(selector string) error {
+	return d.Trigger(selector, "submit", &eventx.BasicEventMap{})
+}
+
+// Trigger dispatches the event object as a event of the giving type to the
+// first markup matching the selector. The event is delivered to all events of
+// the type whose selector matches the markup, the same way the javascript driver
+// matches events. It returns an error if no markup matches or the markup has no
+// events of the type.
+func (d *Driver) Trigger(selector string, eventType string, event interface{}) error {
+	target := d.Query(selector)
+	if target == nil {
+		return fmt.Errorf("%s: %q", ErrNotFound, selector)
+	}
+
+	return d.trigger(target, eventType, event)
+}
+
+// trigger dispatches the event object to the events of the type which match
+// the target.
+func (d *Driver) trigger(target *trees.Markup, eventType string, event interface{}) error {
+	var matched []trees.Event
+
+	for _, view := range d.Views() {
+		view.EachEvent(func(ev *trees.Event, _ *trees.Markup) {
+			if ev.Type != eventType {
+				return
+			}
+
+			// Events bound to their markup are matched directly, as the uid of
+			// markup is not a queryable attribute.
+			if ev.Tree != nil && ev.EventSelector() == ev.Tree.IDSelector(false) {
+				if ev.Tree == target {
+					matched = append(matched, *ev)
+				}
+
+				return
+			}
+
+			for _, item := range queryView(view, ev.EventSelector()) {
+				if item == target {
+					matched = append(matched, *ev)
+					return
+				}
+			}
+		})
+	}
+
+	if len(matched) == 0 {
+		return fmt.Errorf("%s: %q", ErrNoEvent, eventType)
+	}
+
+	for _, ev := range matched {
+		d.app.Notifications().Dispatch(common.EventBroadcast{
+			EventName: ev.EventName(),
+			EventID:   ev.ID(),
+			Event:     eventx.NewBaseEvent(event, ev.Remove),
+		})
+	}
+
+	return nil
+}
+
+// queryView returns all markup of the view, including the view's root, which
+// match the selector and are not removed.
+func queryView(view *trees.Markup, selector string) []*trees.Markup {
+	visible := make(map[*trees.Markup]bool)
+	visibleMarkup(view, visible)
+
+	var found []*trees.Markup
+
+	if visible[view] && trees.Query.Match(view, selector) {
+		found = append(found, view)
+	}
+
+	for _, item := range trees.Query.QueryAll(view, selector) {
+		if visible[item] {
+			found = append(found, item)
+		}
+	}
+
+	return found
+}
+
+// visibleMarkup adds the markup and all it's children which are not removed
+// into the map.
+func visibleMarkup(m *trees.Markup, visible map[*trees.Markup]bool) {
+	if m.Removed() {
+		return
+	}
+
+	visible[m] = true
+
+	for _, child := range m.Children() {
+		visibleMarkup(child, visible)
+	}
+}
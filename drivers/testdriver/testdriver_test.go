@@ -0,0 +1,567 @@
+package testdriver_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/drivers/testdriver"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+)
+
+var success = "✓"
+var failed = "✗"
+
+type page struct{}
+
+func (page) Render() *trees.Markup {
+	return elems.Div(trees.NewAttr("class", "page"))
+}
+
+type counter struct {
+	gu.Reactive
+	count     int
+	name      string
+	submitted bool
+}
+
+func (c *counter) Render() *trees.Markup {
+	return elems.Form(
+		elems.Span(trees.NewAttr("class", "count"), elems.Text("%d", c.count)),
+		elems.Button(trees.NewAttr("class", "add"), events.ClickEvent(func() {
+			c.count++
+		})),
+		elems.Input(trees.NewAttr("class", "name"), events.InputEvent(func(ev common.EventObject) {
+			c.name = ev.Underlying().(*eventx.InputEvent).Data
+		})),
+		events.SubmitEvent(func() {
+			c.submitted = true
+		}),
+	)
+}
+
+func TestDriver(t *testing.T) {
+	app := gu.App("Counter", nil)
+
+	count := &counter{Reactive: gu.NewReactive()}
+	view := app.View(page{}, "*", gu.BodyTarget)
+	view.Component(count, gu.AnyOrder, "", "")
+
+	driver := testdriver.New(app)
+	defer driver.Close()
+
+	if err := driver.Mount("/"); err != nil {
+		t.Fatalf("\t%s\t Should have mounted app: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have mounted app", success)
+
+	if driver.View(view) == nil || driver.Query(".page") == nil {
+		t.Fatalf("\t%s\t Should have rendered view", failed)
+	}
+	t.Logf("\t%s\t Should have rendered view", success)
+
+	if err := driver.Click(".add"); err != nil {
+		t.Fatalf("\t%s\t Should have clicked button: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have clicked button", success)
+
+	if count.count != 1 {
+		t.Fatalf("\t%s\t Should have delivered click event: %d", failed, count.count)
+	}
+	t.Logf("\t%s\t Should have delivered click event", success)
+
+	count.Publish()
+
+	if text := driver.Query(".count").Children()[0].TextContent(); text != "1" {
+		t.Fatalf("\t%s\t Should have updated view markup: %q", failed, text)
+	}
+	t.Logf("\t%s\t Should have updated view markup", success)
+
+	if err := driver.Input(".name", "gu"); err != nil {
+		t.Fatalf("\t%s\t Should have entered input: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have entered input", success)
+
+	if count.name != "gu" {
+		t.Fatalf("\t%s\t Should have delivered input event: %q", failed, count.name)
+	}
+	t.Logf("\t%s\t Should have delivered input event", success)
+
+	if err := driver.Submit("form"); err != nil || !count.submitted {
+		t.Fatalf("\t%s\t Should have delivered submit event: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have delivered submit event", success)
+
+	if err := driver.Click(".count"); err == nil {
+		t.Fatalf("\t%s\t Should have failed to click markup without click event", failed)
+	}
+	t.Logf("\t%s\t Should have failed to click markup without click event", success)
+
+	if err := driver.Click(".missing"); err == nil {
+		t.Fatalf("\t%s\t Should have failed to click missing markup", failed)
+	}
+	t.Logf("\t%s\t Should have failed to click missing markup", success)
+}
+
+func TestDriverIsolatesApps(t *testing.T) {
+	first := gu.App("First", nil)
+	firstCount := &counter{Reactive: gu.NewReactive()}
+	first.View(page{}, "*", gu.BodyTarget).Component(firstCount, gu.AnyOrder, "", "")
+
+	second := gu.App("Second", nil)
+	secondCount := &counter{Reactive: gu.NewReactive()}
+	second.View(page{}, "*", gu.BodyTarget).Component(secondCount, gu.AnyOrder, "", "")
+
+	firstDriver := testdriver.New(first)
+	defer firstDriver.Close()
+
+	secondDriver := testdriver.New(second)
+	defer secondDriver.Close()
+
+	if err := firstDriver.Mount("/"); err != nil {
+		t.Fatalf("\t%s\t Should have mounted first app: %q", failed, err.Error())
+	}
+
+	if err := secondDriver.Mount("/"); err != nil {
+		t.Fatalf("\t%s\t Should have mounted second app: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have mounted both apps", success)
+
+	if err := firstDriver.Click(".add"); err != nil {
+		t.Fatalf("\t%s\t Should have clicked button of first app: %q", failed, err.Error())
+	}
+
+	if firstCount.count != 1 || secondCount.count != 0 {
+		t.Fatalf("\t%s\t Should have delivered click event only to first app: %d, %d", failed, firstCount.count, secondCount.count)
+	}
+	t.Logf("\t%s\t Should have delivered click event only to first app", success)
+
+	secondCount.count = 5
+	firstCount.Publish()
+
+	if text := secondDriver.Query(".count").Children()[0].TextContent(); text != "0" {
+		t.Fatalf("\t%s\t Should have not updated view of second app: %q", failed, text)
+	}
+	t.Logf("\t%s\t Should have not updated view of second app", success)
+
+	if text := firstDriver.Query(".count").Children()[0].TextContent(); text != "1" {
+		t.Fatalf("\t%s\t Should have updated view of first app: %q", failed, text)
+	}
+	t.Logf("\t%s\t Should have updated view of first app", success)
+}
+
+type publisher struct {
+	gu.Reactive
+	count int
+}
+
+func (p *publisher) Render() *trees.Markup {
+	return elems.Button(trees.NewAttr("class", "publish"), elems.Text("%d", p.count), events.ClickEvent(func() {
+		p.count++
+		p.Publish()
+	}))
+}
+
+func TestDriverPublishDuringEvent(t *testing.T) {
+	app := gu.App("Publisher", nil)
+
+	publish := &publisher{Reactive: gu.NewReactive()}
+	app.View(page{}, "*", gu.BodyTarget).Component(publish, gu.AnyOrder, "", "")
+
+	driver := testdriver.New(app)
+	defer driver.Close()
+
+	if err := driver.Mount("/"); err != nil {
+		t.Fatalf("\t%s\t Should have mounted app: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have mounted app", success)
+
+	if err := driver.Click(".publish"); err != nil {
+		t.Fatalf("\t%s\t Should have clicked button: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have clicked button", success)
+
+	if text := driver.Query(".publish").Children()[0].TextContent(); text != "1" {
+		t.Fatalf("\t%s\t Should have updated view published during event: %q", failed, text)
+	}
+	t.Logf("\t%s\t Should have updated view published during event", success)
+
+	if err := driver.Click(".publish"); err != nil {
+		t.Fatalf("\t%s\t Should have clicked re-rendered button: %q", failed, err.Error())
+	}
+
+	if text := driver.Query(".publish").Children()[0].TextContent(); text != "2" {
+		t.Fatalf("\t%s\t Should have delivered event to re-rendered button: %q", failed, text)
+	}
+	t.Logf("\t%s\t Should have delivered event to re-rendered button", success)
+}
+
+// slow defines a handler which responds once the context of the request is
+// done.
+type slow struct {
+	started chan struct{}
+}
+
+func (s slow) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.started <- struct{}{}
+	<-r.Context().Done()
+}
+
+func TestDriverCancelsRequestsOnUnmount(t *testing.T) {
+	handler := slow{started: make(chan struct{}, 1)}
+	app := gu.App("Requests", router.NewRouter(handler, nil))
+
+	view := app.View(page{}, "/home/*", gu.BodyTarget)
+
+	driver := testdriver.New(app)
+	defer driver.Close()
+
+	if err := driver.Mount("/home"); err != nil {
+		t.Fatalf("\t%s\t Should have mounted app: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have mounted app", success)
+
+	failure := make(chan error, 1)
+	go func() {
+		_, err := view.Services().Router.Get("/users", nil)
+		failure <- err
+	}()
+
+	<-handler.started
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/about"})
+
+	select {
+	case err := <-failure:
+		if err != context.Canceled {
+			t.Fatalf("\t%s\t Should have cancelled request of unmounted view: %+q", failed, err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("\t%s\t Should have cancelled request of unmounted view", failed)
+	}
+	t.Logf("\t%s\t Should have cancelled request of unmounted view", success)
+}
+
+type label string
+
+func (l label) Render() *trees.Markup {
+	return elems.Span(trees.NewAttr("class", string(l)))
+}
+
+func TestDriverGuards(t *testing.T) {
+	app := gu.App("Guards", nil)
+
+	var signedIn bool
+
+	login := app.View(page{}, "/login/*", gu.BodyTarget)
+	admin := app.View(page{}, "/admin/*", gu.BodyTarget).Guard(func(router.PushEvent) (bool, string) {
+		if !signedIn {
+			return false, "/#/login"
+		}
+
+		return true, ""
+	})
+
+	private := app.View(page{}, "/private/*", gu.BodyTarget).Guard(func(router.PushEvent) (bool, string) {
+		return false, ""
+	})
+
+	var unmounts int
+	private.Services().Unmounted.React(func() {
+		unmounts++
+	})
+
+	app.View(page{}, "/loop/*", gu.BodyTarget).Guard(func(router.PushEvent) (bool, string) {
+		return false, "/#/loop"
+	})
+
+	home := app.View(page{}, "/home/*", gu.BodyTarget)
+	home.Component(label("open"), gu.AnyOrder, "", "")
+	home.Component(label("hidden"), gu.AnyOrder, "", "").Guard(func(router.PushEvent) (bool, string) {
+		return signedIn, ""
+	})
+
+	driver := testdriver.New(app)
+	defer driver.Close()
+
+	if err := driver.Mount("/#/private"); err != nil {
+		t.Fatalf("\t%s\t Should have mounted app: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have mounted app", success)
+
+	if driver.View(private) != nil || len(app.ActiveViews()) != 0 {
+		t.Fatalf("\t%s\t Should have blocked view by guard", failed)
+	}
+	t.Logf("\t%s\t Should have blocked view by guard", success)
+
+	if unmounts != 0 {
+		t.Fatalf("\t%s\t Should have not unmounted view which was never mounted: %d", failed, unmounts)
+	}
+	t.Logf("\t%s\t Should have not unmounted view which was never mounted", success)
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/admin"})
+
+	if location := driver.Location(); location.Hash != "/login" {
+		t.Fatalf("\t%s\t Should have redirected to login: %q", failed, location.Hash)
+	}
+	t.Logf("\t%s\t Should have redirected to login", success)
+
+	if driver.View(login) == nil || driver.View(admin) != nil {
+		t.Fatalf("\t%s\t Should have rendered only view of redirect", failed)
+	}
+	t.Logf("\t%s\t Should have rendered only view of redirect", success)
+
+	signedIn = true
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/admin"})
+
+	if driver.View(admin) == nil || driver.View(login) != nil {
+		t.Fatalf("\t%s\t Should have rendered view allowed by guard", failed)
+	}
+	t.Logf("\t%s\t Should have rendered view allowed by guard", success)
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/loop"})
+
+	if location := driver.Location(); location.Hash != "/loop" || len(app.ActiveViews()) != 0 {
+		t.Fatalf("\t%s\t Should have stopped redirect loop: %q", failed, location.Hash)
+	}
+	t.Logf("\t%s\t Should have stopped redirect loop", success)
+
+	signedIn = false
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/home"})
+
+	if driver.Query(".open") == nil || driver.Query(".hidden") != nil {
+		t.Fatalf("\t%s\t Should have not rendered component blocked by guard", failed)
+	}
+	t.Logf("\t%s\t Should have not rendered component blocked by guard", success)
+
+	signedIn = true
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/home"})
+
+	if driver.Query(".hidden") == nil {
+		t.Fatalf("\t%s\t Should have rendered component allowed by guard", failed)
+	}
+	t.Logf("\t%s\t Should have rendered component allowed by guard", success)
+}
+
+func TestDriverLoaders(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("Alex"))
+	})
+
+	app := gu.App("Loaders", router.NewRouter(handler, nil))
+
+	var name string
+	var pending bool
+
+	users := app.View(page{}, "/users/*", gu.BodyTarget)
+	users.Load(func(ctx context.Context, rt *router.Router, route router.PushEvent) error {
+		state, _ := users.State()
+		pending = state == gu.ViewPending && len(app.ActiveViews()) == 0
+
+		res, err := rt.GetContext(ctx, "/users/1", nil)
+		if err != nil {
+			return err
+		}
+
+		body, err := router.ReadBody(res)
+		name = string(body)
+		return err
+	})
+
+	broken := app.View(page{}, "/broken/*", gu.BodyTarget).Load(func(context.Context, *router.Router, router.PushEvent) error {
+		return errors.New("unavailable")
+	})
+
+	failures := make(chan gu.ViewLoadFailed, 1)
+	app.Notifications().Subscribe(gu.NewViewLoadFailedHandler(func(failure gu.ViewLoadFailed) {
+		failures <- failure
+	}))
+
+	driver := testdriver.New(app)
+	defer driver.Close()
+
+	if err := driver.Mount("/#/users"); err != nil {
+		t.Fatalf("\t%s\t Should have mounted app: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have mounted app", success)
+
+	if !pending {
+		t.Fatalf("\t%s\t Should have run loader before activating view", failed)
+	}
+	t.Logf("\t%s\t Should have run loader before activating view", success)
+
+	if state, err := users.State(); state != gu.ViewReady || err != nil || name != "Alex" || driver.View(users) == nil {
+		t.Fatalf("\t%s\t Should have rendered view once loader finished: %q %+q", failed, name, err)
+	}
+	t.Logf("\t%s\t Should have rendered view once loader finished", success)
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/broken"})
+
+	if state, err := broken.State(); state != gu.ViewFailed || err == nil || driver.View(broken) != nil {
+		t.Fatalf("\t%s\t Should have not rendered view with failed loader", failed)
+	}
+	t.Logf("\t%s\t Should have not rendered view with failed loader", success)
+
+	select {
+	case failure := <-failures:
+		if failure.View != broken || failure.Err == nil || failure.Route.Hash != "/broken" {
+			t.Fatalf("\t%s\t Should have notified failed loader: %+q", failed, failure.Err)
+		}
+	default:
+		t.Fatalf("\t%s\t Should have notified failed loader", failed)
+	}
+	t.Logf("\t%s\t Should have notified failed loader", success)
+}
+
+func TestDriverHistory(t *testing.T) {
+	app := gu.App("History", nil)
+
+	home := app.View(page{}, "/home/*", gu.BodyTarget)
+	users := app.View(page{}, "/users/*", gu.BodyTarget)
+	login := app.View(page{}, "/login/*", gu.BodyTarget)
+
+	app.View(page{}, "/admin/*", gu.BodyTarget).Guard(func(router.PushEvent) (bool, string) {
+		return false, "/#/login"
+	})
+
+	driver := testdriver.New(app)
+	defer driver.Close()
+
+	if err := driver.Mount("/#/home"); err != nil {
+		t.Fatalf("\t%s\t Should have mounted app: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have mounted app", success)
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/users"})
+	driver.SaveScroll(router.ScrollPosition{Y: 120})
+
+	if !app.Back() || driver.View(home) == nil || driver.Location().Hash != "/home" {
+		t.Fatalf("\t%s\t Should have moved back to previous route", failed)
+	}
+	t.Logf("\t%s\t Should have moved back to previous route", success)
+
+	if !app.Forward() || driver.View(users) == nil || driver.Scroll().Y != 120 {
+		t.Fatalf("\t%s\t Should have moved forward with saved scroll: %v", failed, driver.Scroll())
+	}
+	t.Logf("\t%s\t Should have moved forward with saved scroll", success)
+
+	if app.Forward() {
+		t.Fatalf("\t%s\t Should have not moved past last route", failed)
+	}
+	t.Logf("\t%s\t Should have not moved past last route", success)
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/admin"})
+
+	if driver.View(login) == nil {
+		t.Fatalf("\t%s\t Should have redirected to login", failed)
+	}
+
+	if !app.Back() || driver.View(users) == nil {
+		t.Fatalf("\t%s\t Should have replaced redirected route: %q", failed, driver.Location().Hash)
+	}
+	t.Logf("\t%s\t Should have replaced redirected route", success)
+}
+
+// layout defines a renderable with a outlet, which counts it's renders.
+type layout struct {
+	renders int
+}
+
+func (l *layout) Render() *trees.Markup {
+	l.renders++
+
+	return elems.Div(
+		trees.NewAttr("class", "layout"),
+		elems.Div(trees.NewAttr("class", "content")),
+		elems.Div(trees.NewAttr("class", "content aside")),
+	)
+}
+
+func TestDriverOutlets(t *testing.T) {
+	app := gu.App("Outlets", nil)
+
+	frame := &layout{}
+	settings := app.View(frame, "/settings/*", gu.BodyTarget).Outlet("main", ".content")
+
+	count := &counter{Reactive: gu.NewReactive()}
+	profile := settings.View(label("profile"), "/profile", "main")
+	profile.Component(count, gu.AnyOrder, "", "")
+
+	account := settings.View(label("account"), "/account", "main")
+	settings.View(label("private"), "/private", "main").Guard(func(router.PushEvent) (bool, string) {
+		return false, ""
+	})
+
+	driver := testdriver.New(app)
+	defer driver.Close()
+
+	if err := driver.Mount("/#/settings/profile"); err != nil {
+		t.Fatalf("\t%s\t Should have mounted app: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have mounted app", success)
+
+	if driver.Query(".content .profile") == nil || driver.Query(".account") != nil || settings.Outlets()["main"] != profile {
+		t.Fatalf("\t%s\t Should have rendered nested view into outlet: %s", failed, driver.HTML())
+	}
+	t.Logf("\t%s\t Should have rendered nested view into outlet", success)
+
+	if len(driver.QueryAll(".profile")) != 1 || driver.Query(".aside .profile") != nil {
+		t.Fatalf("\t%s\t Should have rendered nested view into first markup matching outlet: %s", failed, driver.HTML())
+	}
+	t.Logf("\t%s\t Should have rendered nested view into first markup matching outlet", success)
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/settings/account"})
+
+	if driver.Query(".content .account") == nil || driver.Query(".profile") != nil || settings.Outlets()["main"] != account {
+		t.Fatalf("\t%s\t Should have rendered sibling nested view into outlet: %s", failed, driver.HTML())
+	}
+	t.Logf("\t%s\t Should have rendered sibling nested view into outlet", success)
+
+	if frame.renders != 1 {
+		t.Fatalf("\t%s\t Should have only rendered outlet between siblings: %d", failed, frame.renders)
+	}
+	t.Logf("\t%s\t Should have only rendered outlet between siblings", success)
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/settings/private"})
+
+	if driver.Query(".layout") == nil || driver.Query(".private") != nil || len(settings.Outlets()) != 0 {
+		t.Fatalf("\t%s\t Should have left outlet of blocked nested view empty: %s", failed, driver.HTML())
+	}
+	t.Logf("\t%s\t Should have left outlet of blocked nested view empty", success)
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/settings/profile"})
+
+	if err := driver.Click(".add"); err != nil {
+		t.Fatalf("\t%s\t Should have clicked button of nested view: %q", failed, err.Error())
+	}
+
+	count.Publish()
+
+	if text := driver.Query(".count").Children()[0].TextContent(); text != "1" || frame.renders != 1 {
+		t.Fatalf("\t%s\t Should have updated nested view: %q %d", failed, text, frame.renders)
+	}
+	t.Logf("\t%s\t Should have updated nested view", success)
+
+	settings.Publish()
+
+	if driver.Query(".layout") == nil || driver.Query(".count") == nil || frame.renders != 2 {
+		t.Fatalf("\t%s\t Should have rendered view with outlets once changed: %d", failed, frame.renders)
+	}
+	t.Logf("\t%s\t Should have rendered view with outlets once changed", success)
+
+	driver.Navigate(router.PushDirectiveEvent{To: "/#/about"})
+
+	if driver.Query(".layout") != nil || len(settings.Outlets()) != 0 {
+		t.Fatalf("\t%s\t Should have unmounted nested views with view", failed)
+	}
+	t.Logf("\t%s\t Should have unmounted nested views with view", success)
+}
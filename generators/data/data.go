@@ -47,7 +47,7 @@ func init() {
 
 	files["scaffolds/pack-bundle-public.gen"] = []byte("\x2f\x2f\x2b\x62\x75\x69\x6c\x64\x20\x69\x67\x6e\x6f\x72\x65\x0d\x0a\x0d\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x6d\x61\x69\x6e\x0d\x0a\x0d\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0d\x0a\x09\x22\x66\x6d\x74\x22\x0d\x0a\x09\x22\x6f\x73\x22\x0d\x0a\x20\x20\x20\x20\x22\x70\x61\x74\x68\x2f\x66\x69\x6c\x65\x70\x61\x74\x68\x22\x0d\x0a\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x42\x75\x72\x6e\x74\x53\x75\x73\x68\x69\x2f\x74\x6f\x6d\x6c\x22\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x75\x2d\x69\x6f\x2f\x67\x75\x2f\x63\x6f\x6d\x6d\x6f\x6e\x22\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x75\x2d\x69\x6f\x2f\x67\x75\x2f\x61\x73\x73\x65\x74\x73\x22\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x75\x2d\x69\x6f\x2f\x67\x75\x2f\x61\x73\x73\x65\x74\x73\x2f\x70\x61\x63\x6b\x65\x72\x73\x22\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x6d\x6f\x7a\x2f\x67\x65\x6e\x22\x0d\x0a\x29\x0d\x0a\x0d\x0a\x66\x75\x6e\x63\x20\x6d\x61\x69\x6e\x28\x29\x7b\x0d\x0a\x20\x20\x76\x61\x72\x20\x63\x6f\x6e\x66\x69\x67\x20\x63\x6f\x6d\x6d\x6f\x6e\x2e\x53\x65\x74\x74\x69\x6e\x67\x73\x0d\x0a\x0d\x0a\x20\x20\x2f\x2f\x20\x4c\x6f\x61\x64\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x20\x69\x6e\x74\x6f\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x2e\x0d\x0a\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x74\x6f\x6d\x6c\x2e\x44\x65\x63\x6f\x64\x65\x46\x69\x6c\x65\x28\x22\x2e\x2f\x73\x65\x74\x74\x69\x6e\x67\x73\x2e\x74\x6f\x6d\x6c\x22\x2c\x20\x26\x63\x6f\x6e\x66\x69\x67\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x63\x6f\x6e\x66\x69\x67\x2e\x56\x61\x6c\x69\x64\x61\x74\x65\x28\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x73\x2e\x4e\x65\x77\x28\x70\x61\x63\x6b\x65\x72\x73\x2e\x52\x61\x77\x50\x61\x63\x6b\x65\x72\x7b\x7d\x29\x0d\x0a\x0d\x0a\x09\x6a\x73\x70\x61\x63\x6b\x65\x72\x20\x3a\x3d\x20\x70\x61\x63\x6b\x65\x72\x73\x2e\x4a\x53\x50\x61\x63\x6b\x65\x72\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x45\x78\x63\x65\x70\x74\x69\x6f\x6e\x73\x3a\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x6c\x65\x70\x61\x74\x68\x2e\x4a\x6f\x69\x6e\x28\x63\x6f\x6e\x66\x69\x67\x2e\x50\x75\x62\x6c\x69\x63\x2e\x50\x61\x74\x68\x2c\x22\x6a\x73\x22\x2c\x20\x63\x6f\x6e\x66\x69\x67\x2e\x53\x74\x61\x74\x69\x63\x2e\x4a\x53\x46\x69\x6c\x65\x4e\x61\x6d\x65\x29\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x69\x6c\x65\x70\x61\x74\x68\x2e\x4a\x6f\x69\x6e\x28\x63\x6f\x6e\x66\x69\x67\x2e\x50\x75\x62\x6c\x69\x63\x2e\x50\x61\x74\x68\x2c\x22\x6a\x73\x22\x2c\x20\x63\x6f\x6e\x66\x69\x67\x2e\x53\x74\x61\x74\x69\x63\x2e\x4a\x53\x4d\x61\x70\x46\x69\x6c\x65\x4e\x61\x6d\x65\x29\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x52\x65\x67\x69\x73\x74\x65\x72\x28\x22\x2e\x6a\x73\x22\x2c\x20\x26\x6a\x73\x70\x61\x63\x6b\x65\x72\x29\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x52\x65\x67\x69\x73\x74\x65\x72\x28\x22\x2e\x6a\x73\x2e\x6d\x61\x70\x22\x2c\x20\x26\x6a\x73\x70\x61\x63\x6b\x65\x72\x29\x0d\x0a\x09\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x52\x65\x67\x69\x73\x74\x65\x72\x28\x22\x2e\x63\x73\x73\x22\x2c\x20\x70\x61\x63\x6b\x65\x72\x73\x2e\x43\x53\x53\x50\x61\x63\x6b\x65\x72\x7b\x43\x6c\x65\x61\x6e\x43\x53\x53\x3a\x20\x74\x72\x75\x65\x7d\x29\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x52\x65\x67\x69\x73\x74\x65\x72\x28\x22\x2e\x73\x74\x61\x74\x69\x63\x2e\x68\x74\x6d\x6c\x22\x2c\x20\x70\x61\x63\x6b\x65\x72\x73\x2e\x53\x74\x61\x74\x69\x63\x4d\x61\x72\x6b\x75\x70\x50\x61\x63\x6b\x65\x72\x7b\x0d\x0a\x09\x09\x50\x61\x63\x6b\x61\x67\x65\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x54\x61\x72\x67\x65\x74\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x2c\x0d\x0a\x09\x09\x44\x65\x73\x74\x69\x6e\x61\x74\x69\x6f\x6e\x46\x69\x6c\x65\x3a\x20\x22\x7b\x7b\x2e\x54\x61\x72\x67\x65\x74\x44\x69\x72\x7d\x7d\x2f\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x5f\x73\x74\x61\x74\x69\x63\x5f\x62\x75\x6e\x64\x6c\x65\x2e\x67\x6f\x22\x2c\x0d\x0a\x09\x7d\x29\x0d\x0a\x0d\x0a\x09\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x2e\x4c\x65\x73\x73\x46\x69\x6c\x65\x20\x22\x22\x20\x7d\x7d\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x52\x65\x67\x69\x73\x74\x65\x72\x28\x22\x2e\x6c\x65\x73\x73\x22\x2c\x20\x70\x61\x63\x6b\x65\x72\x73\x2e\x4c\x65\x73\x73\x50\x61\x63\x6b\x65\x72\x7b\x4d\x61\x69\x6e\x46\x69\x6c\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4c\x65\x73\x73\x46\x69\x6c\x65\x7d\x7d\x20\x7d\x29\x0d\x0a\x09\x7b\x7b\x20\x65\x6e\x64\x7d\x7d\x0d\x0a\x0d\x0a\x20\x20\x77\x72\x69\x74\x65\x72\x2c\x20\x73\x74\x61\x74\x69\x63\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x43\x6f\x6d\x70\x69\x6c\x65\x28\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x54\x61\x72\x67\x65\x74\x44\x69\x72\x7d\x7d\x2c\x20\x66\x61\x6c\x73\x65\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x09\x70\x69\x70\x65\x47\x65\x6e\x20\x3a\x3d\x20\x67\x65\x6e\x2e\x42\x6c\x6f\x63\x6b\x28\x0d\x0a\x09\x09\x67\x65\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x28\x0d\x0a\x09\x09\x09\x67\x65\x6e\x2e\x4e\x61\x6d\x65\x28\x22\x7b\x7b\x2e\x54\x61\x72\x67\x65\x74\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x22\x29\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x77\x72\x69\x74\x65\x72\x2c\x0d\x0a\x20\x20\x20\x20\x29\x2c\x0d\x0a\x20\x20\x29\x0d\x0a\x0d\x0a\x09\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x47\x65\x74\x77\x64\x28\x29\x0d\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x09\x7d\x0d\x0a\x0d\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x72\x69\x74\x65\x54\x6f\x46\x69\x6c\x65\x28\x70\x69\x70\x65\x47\x65\x6e\x2c\x66\x6d\x74\x2e\x53\x70\x72\x69\x6e\x74\x66\x28\x22\x25\x73\x5f\x62\x75\x6e\x64\x6c\x65\x2e\x67\x6f\x22\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x29\x2c\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x54\x61\x72\x67\x65\x74\x44\x69\x72\x7d\x7d\x2c\x20\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x09\x7d\x0d\x0a\x0d\x0a\x09\x66\x6f\x72\x20\x5f\x2c\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x73\x74\x61\x74\x69\x63\x73\x20\x7b\x0d\x0a\x09\x09\x66\x6f\x72\x20\x5f\x2c\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7b\x0d\x0a\x09\x09\x09\x69\x66\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x2e\x53\x74\x61\x74\x69\x63\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x09\x09\x63\x6f\x6e\x74\x69\x6e\x75\x65\x0d\x0a\x09\x09\x09\x7d\x0d\x0a\x0d\x0a\x09\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x72\x69\x74\x65\x54\x6f\x46\x69\x6c\x65\x28\x64\x69\x72\x65\x63\x74\x69\x76\x65\x2e\x57\x72\x69\x74\x65\x72\x2c\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x2e\x53\x74\x61\x74\x69\x63\x2e\x46\x69\x6c\x65\x4e\x61\x6d\x65\x2c\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x2e\x53\x74\x61\x74\x69\x63\x2e\x44\x69\x72\x4e\x61\x6d\x65\x2c\x20\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x09\x09\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x09\x09\x09\x7d\x0d\x0a\x09\x09\x7d\x0d\x0a\x09\x7d\x0d\x0a\x0d\x0a\x20\x20\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x6c\x6e\x28\x22\x42\x75\x6e\x64\x6c\x69\x6e\x67\x20\x63\x6f\x6d\x70\x6c\x65\x74\x65\x64\x20\x66\x6f\x72\x20\x27\x7b\x7b\x2e\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x27\x22\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x77\x72\x69\x74\x65\x54\x6f\x46\x69\x6c\x65\x20\x77\x72\x69\x74\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x57\x72\x69\x74\x65\x72\x54\x6f\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x74\x6f\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x6f\x66\x0d\x0a\x2f\x2f\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x66\x69\x6c\x65\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x77\x72\x69\x74\x65\x54\x6f\x46\x69\x6c\x65\x28\x77\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x54\x6f\x2c\x20\x66\x69\x6c\x65\x4e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x69\x72\x4e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0d\x0a\x09\x63\x6f\x44\x69\x72\x20\x3a\x3d\x20\x66\x69\x6c\x65\x70\x61\x74\x68\x2e\x4a\x6f\x69\x6e\x28\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x2c\x20\x64\x69\x72\x4e\x61\x6d\x65\x29\x0d\x0a\x0d\x0a\x09\x69\x66\x20\x64\x69\x72\x4e\x61\x6d\x65\x20\x21\x3d\x20\x22\x22\x20\x7b\x0d\x0a\x09\x09\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x53\x74\x61\x74\x28\x63\x6f\x44\x69\x72\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x4d\x6b\x64\x69\x72\x41\x6c\x6c\x28\x63\x6f\x44\x69\x72\x2c\x20\x30\x37\x30\x30\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x6f\x73\x2e\x45\x72\x72\x45\x78\x69\x73\x74\x20\x7b\x0d\x0a\x09\x09\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0d\x0a\x09\x09\x09\x09\x7d\x0d\x0a\x0d\x0a\x09\x09\x09\x09\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x66\x28\x22\x2d\x20\x43\x72\x65\x61\x74\x65\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x3a\x20\x25\x71\x5c\x6e\x22\x2c\x20\x63\x6f\x44\x69\x72\x29\x0d\x0a\x09\x09\x7d\x0d\x0a\x09\x7d\x0d\x0a\x0d\x0a\x09\x63\x6f\x46\x69\x6c\x65\x20\x3a\x3d\x20\x66\x69\x6c\x65\x70\x61\x74\x68\x2e\x4a\x6f\x69\x6e\x28\x63\x6f\x44\x69\x72\x2c\x20\x66\x69\x6c\x65\x4e\x61\x6d\x65\x29\x0d\x0a\x09\x66\x69\x6c\x65\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x43\x72\x65\x61\x74\x65\x28\x63\x6f\x46\x69\x6c\x65\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x64\x65\x66\x65\x72\x20\x66\x69\x6c\x65\x2e\x43\x6c\x6f\x73\x65\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x2e\x57\x72\x69\x74\x65\x54\x6f\x28\x66\x69\x6c\x65\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x09\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x66\x28\x22\x2d\x20\x43\x72\x65\x61\x74\x65\x64\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x66\x69\x6c\x65\x3a\x20\x25\x71\x5c\x6e\x22\x2c\x20\x66\x69\x6c\x65\x70\x61\x74\x68\x2e\x4a\x6f\x69\x6e\x28\x64\x69\x72\x4e\x61\x6d\x65\x2c\x20\x66\x69\x6c\x65\x4e\x61\x6d\x65\x29\x29\x0d\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a")
 
-	files["scaffolds/pack-bundle-src.gen"] = []byte("\x0d\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0d\x0a\x09\x22\x62\x79\x74\x65\x73\x22\x0d\x0a\x09\x22\x63\x6f\x6d\x70\x72\x65\x73\x73\x2f\x67\x7a\x69\x70\x22\x0d\x0a\x09\x22\x66\x6d\x74\x22\x0d\x0a\x09\x22\x69\x6f\x22\x0d\x0a\x09\x22\x73\x79\x6e\x63\x22\x0d\x0a\x29\x0d\x0a\x0d\x0a\x74\x79\x70\x65\x20\x66\x69\x6c\x65\x44\x61\x74\x61\x20\x73\x74\x72\x75\x63\x74\x7b\x0d\x0a\x20\x20\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x0d\x0a\x20\x20\x72\x6f\x6f\x74\x20\x73\x74\x72\x69\x6e\x67\x0d\x0a\x20\x20\x64\x61\x74\x61\x20\x5b\x5d\x62\x79\x74\x65\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x76\x61\x72\x20\x28\x0d\x0a\x20\x20\x61\x73\x73\x65\x74\x73\x20\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x65\x78\x74\x2c\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x3a\x3d\x20\x2e\x44\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x24\x65\x78\x74\x7d\x7d\x3a\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x20\x20\x2f\x2f\x20\x61\x6c\x6c\x20\x7b\x7b\x20\x24\x65\x78\x74\x20\x7d\x7d\x20\x61\x73\x73\x65\x74\x73\x2e\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x50\x61\x74\x68\x7d\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x73\x20\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x66\x69\x6c\x65\x44\x61\x74\x61\x7b\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x65\x78\x74\x2c\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x3a\x3d\x20\x2e\x44\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x50\x61\x74\x68\x7d\x7d\x3a\x20\x7b\x20\x2f\x2f\x20\x61\x6c\x6c\x20\x7b\x7b\x20\x24\x65\x78\x74\x20\x7d\x7d\x20\x61\x73\x73\x65\x74\x73\x2e\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x5b\x5d\x62\x79\x74\x65\x28\x22\x7b\x7b\x2e\x52\x65\x61\x64\x20\x7d\x7d\x22\x29\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x74\x68\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x50\x61\x74\x68\x20\x7d\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x6f\x6f\x74\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x41\x62\x73\x50\x61\x74\x68\x20\x7d\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x7d\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x20\x3d\x20\x73\x74\x72\x75\x63\x74\x7b\x0d\x0a\x09\x09\x6d\x6c\x20\x73\x79\x6e\x63\x2e\x52\x57\x4d\x75\x74\x65\x78\x0d\x0a\x09\x09\x63\x61\x63\x68\x65\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x73\x74\x72\x69\x6e\x67\x0d\x0a\x09\x7d\x7b\x0d\x0a\x09\x09\x63\x61\x63\x68\x65\x3a\x20\x6d\x61\x6b\x65\x28\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x30\x29\x2c\x0d\x0a\x09\x7d\x0d\x0a\x29\x0d\x0a\x0d\x0a\x2f\x2f\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x46\x69\x6c\x65\x73\x46\x6f\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x6c\x6c\x20\x66\x69\x6c\x65\x73\x20\x74\x68\x61\x74\x20\x75\x73\x65\x20\x74\x68\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x65\x78\x74\x65\x6e\x73\x69\x6f\x6e\x2c\x20\x72\x65\x74\x75\x72\x6e\x69\x6e\x67\x20\x61\x0d\x0a\x2f\x2f\x20\x65\x6d\x70\x74\x79\x2f\x6e\x69\x6c\x20\x73\x6c\x69\x63\x65\x20\x69\x66\x20\x6e\x6f\x6e\x65\x20\x69\x73\x20\x66\x6f\x75\x6e\x64\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x46\x69\x6c\x65\x73\x46\x6f\x72\x28\x65\x78\x74\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x20\x7b\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x73\x73\x65\x74\x73\x5b\x65\x78\x74\x5d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4d\x75\x73\x74\x46\x69\x6e\x64\x46\x69\x6c\x65\x20\x63\x61\x6c\x6c\x73\x20\x46\x69\x6e\x64\x46\x69\x6c\x65\x20\x74\x6f\x20\x72\x65\x74\x72\x69\x65\x76\x65\x20\x66\x69\x6c\x65\x20\x72\x65\x61\x64\x65\x72\x20\x77\x69\x74\x68\x20\x70\x61\x74\x68\x20\x65\x6c\x73\x65\x20\x70\x61\x6e\x69\x63\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4d\x75\x73\x74\x46\x69\x6e\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x20\x7b\x0d\x0a\x20\x20\x72\x65\x61\x64\x65\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x46\x69\x6e\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x61\x64\x65\x72\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x46\x69\x6e\x64\x46\x69\x6c\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x20\x62\x79\x20\x73\x65\x65\x6b\x69\x6e\x67\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x66\x69\x6c\x65\x20\x70\x61\x74\x68\x20\x69\x66\x20\x69\x74\x20\x65\x78\x69\x73\x74\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x46\x69\x6e\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x28\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x65\x72\x72\x6f\x72\x29\x7b\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x52\x4c\x6f\x63\x6b\x28\x29\x0d\x0a\x09\x69\x66\x20\x64\x61\x74\x61\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x63\x61\x63\x68\x65\x5b\x70\x61\x74\x68\x5d\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x09\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x52\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x62\x79\x74\x65\x73\x2e\x4e\x65\x77\x42\x75\x66\x66\x65\x72\x53\x74\x72\x69\x6e\x67\x28\x64\x61\x74\x61\x29\x2c\x20\x6e\x69\x6c\x0d\x0a\x09\x7d\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x52\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x69\x74\x65\x6d\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x73\x5b\x70\x61\x74\x68\x5d\x0d\x0a\x20\x20\x69\x66\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x46\x69\x6c\x65\x20\x25\x71\x20\x6e\x6f\x74\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x66\x69\x6c\x65\x20\x73\x79\x73\x74\x65\x6d\x22\x2c\x20\x70\x61\x74\x68\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x21\x64\x6f\x47\x7a\x69\x70\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x79\x74\x65\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x69\x74\x65\x6d\x2e\x64\x61\x74\x61\x29\x2c\x20\x6e\x69\x6c\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x67\x7a\x69\x70\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x62\x79\x74\x65\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x69\x74\x65\x6d\x2e\x64\x61\x74\x61\x29\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4d\x75\x73\x74\x52\x65\x61\x64\x46\x69\x6c\x65\x20\x63\x61\x6c\x6c\x73\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x20\x74\x6f\x20\x72\x65\x74\x72\x69\x65\x76\x65\x20\x66\x69\x6c\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x77\x69\x74\x68\x20\x70\x61\x74\x68\x20\x65\x6c\x73\x65\x20\x70\x61\x6e\x69\x63\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4d\x75\x73\x74\x52\x65\x61\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x73\x74\x72\x69\x6e\x67\x20\x7b\x0d\x0a\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x20\x20\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x6f\x64\x79\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x20\x61\x74\x74\x65\x6d\x70\x74\x73\x20\x74\x6f\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x65\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x20\x64\x61\x74\x61\x20\x61\x73\x73\x6f\x63\x69\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x70\x61\x74\x68\x0d\x0a\x2f\x2f\x20\x69\x66\x20\x69\x74\x20\x65\x78\x69\x73\x74\x73\x20\x65\x6c\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x6e\x20\x65\x72\x72\x6f\x72\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x65\x72\x72\x6f\x72\x29\x7b\x0d\x0a\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x73\x74\x72\x69\x6e\x67\x28\x62\x6f\x64\x79\x29\x2c\x20\x65\x72\x72\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4d\x75\x73\x74\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x20\x63\x61\x6c\x6c\x73\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x20\x74\x6f\x20\x72\x65\x74\x72\x69\x65\x76\x65\x20\x66\x69\x6c\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x77\x69\x74\x68\x20\x70\x61\x74\x68\x20\x65\x6c\x73\x65\x20\x70\x61\x6e\x69\x63\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4d\x75\x73\x74\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x5b\x5d\x62\x79\x74\x65\x20\x7b\x0d\x0a\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x6f\x64\x79\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x20\x61\x74\x74\x65\x6d\x70\x74\x73\x20\x74\x6f\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x65\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x20\x64\x61\x74\x61\x20\x61\x73\x73\x6f\x63\x69\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x70\x61\x74\x68\x0d\x0a\x2f\x2f\x20\x69\x66\x20\x69\x74\x20\x65\x78\x69\x73\x74\x73\x20\x65\x6c\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x6e\x20\x65\x72\x72\x6f\x72\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x28\x5b\x5d\x62\x79\x74\x65\x2c\x20\x65\x72\x72\x6f\x72\x29\x7b\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x52\x4c\x6f\x63\x6b\x28\x29\x0d\x0a\x09\x69\x66\x20\x64\x61\x74\x61\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x63\x61\x63\x68\x65\x5b\x70\x61\x74\x68\x5d\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x09\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x52\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x5b\x5d\x62\x79\x74\x65\x28\x64\x61\x74\x61\x29\x2c\x20\x6e\x69\x6c\x0d\x0a\x09\x7d\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x52\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x61\x64\x65\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x46\x69\x6e\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x63\x6c\x6f\x73\x65\x72\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x72\x65\x61\x64\x65\x72\x2e\x28\x69\x6f\x2e\x43\x6c\x6f\x73\x65\x72\x29\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x63\x6c\x6f\x73\x65\x72\x2e\x43\x6c\x6f\x73\x65\x28\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x76\x61\x72\x20\x62\x75\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0d\x0a\x0d\x0a\x20\x20\x5f\x2c\x20\x65\x72\x72\x20\x3d\x20\x69\x6f\x2e\x43\x6f\x70\x79\x28\x26\x62\x75\x2c\x20\x72\x65\x61\x64\x65\x72\x29\x3b\x20\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0d\x0a\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x46\x69\x6c\x65\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x62\x65\x20\x72\x65\x61\x64\x3a\x20\x25\x2b\x71\x22\x2c\x20\x70\x61\x74\x68\x2c\x20\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x4c\x6f\x63\x6b\x28\x29\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x63\x61\x63\x68\x65\x5b\x70\x61\x74\x68\x5d\x20\x3d\x20\x73\x74\x72\x69\x6e\x67\x28\x62\x75\x2e\x42\x79\x74\x65\x73\x28\x29\x29\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x75\x2e\x42\x79\x74\x65\x73\x28\x29\x2c\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a")
+	files["scaffolds/pack-bundle-src.gen"] = []byte("\x0d\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0d\x0a\x09\x22\x62\x79\x74\x65\x73\x22\x0d\x0a\x09\x22\x63\x6f\x6d\x70\x72\x65\x73\x73\x2f\x67\x7a\x69\x70\x22\x0d\x0a\x09\x22\x65\x6e\x63\x6f\x64\x69\x6e\x67\x2f\x62\x69\x6e\x61\x72\x79\x22\x0d\x0a\x09\x22\x66\x6d\x74\x22\x0d\x0a\x09\x22\x69\x6f\x22\x0d\x0a\x09\x22\x69\x6f\x2f\x66\x73\x22\x0d\x0a\x09\x22\x6d\x69\x6d\x65\x22\x0d\x0a\x09\x22\x6e\x65\x74\x2f\x68\x74\x74\x70\x22\x0d\x0a\x09\x22\x70\x61\x74\x68\x22\x0d\x0a\x09\x22\x73\x6f\x72\x74\x22\x0d\x0a\x09\x22\x73\x74\x72\x63\x6f\x6e\x76\x22\x0d\x0a\x09\x22\x73\x74\x72\x69\x6e\x67\x73\x22\x0d\x0a\x09\x22\x73\x79\x6e\x63\x22\x0d\x0a\x09\x22\x74\x69\x6d\x65\x22\x0d\x0a\x29\x0d\x0a\x0d\x0a\x74\x79\x70\x65\x20\x66\x69\x6c\x65\x44\x61\x74\x61\x20\x73\x74\x72\x75\x63\x74\x7b\x0d\x0a\x20\x20\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x0d\x0a\x20\x20\x72\x6f\x6f\x74\x20\x73\x74\x72\x69\x6e\x67\x0d\x0a\x20\x20\x64\x61\x74\x61\x20\x5b\x5d\x62\x79\x74\x65\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x73\x69\x7a\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x73\x69\x7a\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x2c\x20\x77\x68\x69\x63\x68\x20\x69\x73\x20\x73\x74\x6f\x72\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x0d\x0a\x2f\x2f\x20\x6c\x61\x73\x74\x20\x34\x20\x62\x79\x74\x65\x73\x20\x6f\x66\x20\x69\x74\x27\x73\x20\x67\x7a\x69\x70\x70\x65\x64\x20\x64\x61\x74\x61\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x66\x20\x66\x69\x6c\x65\x44\x61\x74\x61\x29\x20\x73\x69\x7a\x65\x28\x29\x20\x69\x6e\x74\x36\x34\x20\x7b\x0d\x0a\x20\x20\x69\x66\x20\x6c\x65\x6e\x28\x66\x2e\x64\x61\x74\x61\x29\x20\x3c\x20\x34\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x30\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x74\x36\x34\x28\x62\x69\x6e\x61\x72\x79\x2e\x4c\x69\x74\x74\x6c\x65\x45\x6e\x64\x69\x61\x6e\x2e\x55\x69\x6e\x74\x33\x32\x28\x66\x2e\x64\x61\x74\x61\x5b\x6c\x65\x6e\x28\x66\x2e\x64\x61\x74\x61\x29\x2d\x34\x3a\x5d\x29\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x76\x61\x72\x20\x28\x0d\x0a\x20\x20\x61\x73\x73\x65\x74\x73\x20\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x65\x78\x74\x2c\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x3a\x3d\x20\x2e\x44\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x24\x65\x78\x74\x7d\x7d\x3a\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x7b\x20\x20\x2f\x2f\x20\x61\x6c\x6c\x20\x7b\x7b\x20\x24\x65\x78\x74\x20\x7d\x7d\x20\x61\x73\x73\x65\x74\x73\x2e\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x50\x61\x74\x68\x7d\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x73\x20\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x66\x69\x6c\x65\x44\x61\x74\x61\x7b\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x65\x78\x74\x2c\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x3a\x3d\x20\x2e\x44\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x50\x61\x74\x68\x7d\x7d\x3a\x20\x7b\x20\x2f\x2f\x20\x61\x6c\x6c\x20\x7b\x7b\x20\x24\x65\x78\x74\x20\x7d\x7d\x20\x61\x73\x73\x65\x74\x73\x2e\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x5b\x5d\x62\x79\x74\x65\x28\x22\x7b\x7b\x2e\x52\x65\x61\x64\x20\x7d\x7d\x22\x29\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x74\x68\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x50\x61\x74\x68\x20\x7d\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x6f\x6f\x74\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x41\x62\x73\x50\x61\x74\x68\x20\x7d\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x7d\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x61\x73\x73\x65\x74\x46\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x73\x20\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x73\x74\x72\x69\x6e\x67\x7b\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x3a\x3d\x20\x2e\x44\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x50\x61\x74\x68\x7d\x7d\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x46\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x7d\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x61\x73\x73\x65\x74\x50\x61\x74\x68\x73\x20\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x73\x74\x72\x69\x6e\x67\x7b\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x3a\x3d\x20\x2e\x44\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x46\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x7d\x7d\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x50\x61\x74\x68\x7d\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x61\x73\x73\x65\x74\x48\x61\x73\x68\x65\x73\x20\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x73\x74\x72\x69\x6e\x67\x7b\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x3a\x3d\x20\x2e\x44\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x72\x61\x6e\x67\x65\x20\x24\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4f\x72\x69\x67\x69\x6e\x50\x61\x74\x68\x7d\x7d\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x48\x61\x73\x68\x7d\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x7b\x7b\x20\x65\x6e\x64\x20\x7d\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x20\x3d\x20\x73\x74\x72\x75\x63\x74\x7b\x0d\x0a\x09\x09\x6d\x6c\x20\x73\x79\x6e\x63\x2e\x52\x57\x4d\x75\x74\x65\x78\x0d\x0a\x09\x09\x63\x61\x63\x68\x65\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x5b\x5d\x62\x79\x74\x65\x0d\x0a\x09\x7d\x7b\x0d\x0a\x09\x09\x63\x61\x63\x68\x65\x3a\x20\x6d\x61\x6b\x65\x28\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x5b\x5d\x62\x79\x74\x65\x2c\x20\x30\x29\x2c\x0d\x0a\x09\x7d\x0d\x0a\x0d\x0a\x09\x61\x73\x73\x65\x74\x44\x69\x72\x73\x20\x3d\x20\x73\x74\x72\x75\x63\x74\x7b\x0d\x0a\x09\x09\x6f\x6e\x63\x65\x20\x73\x79\x6e\x63\x2e\x4f\x6e\x63\x65\x0d\x0a\x09\x09\x65\x6e\x74\x72\x69\x65\x73\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x5b\x5d\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x0d\x0a\x09\x7d\x7b\x7d\x0d\x0a\x29\x0d\x0a\x0d\x0a\x2f\x2f\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x46\x69\x6c\x65\x73\x46\x6f\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x6c\x6c\x20\x66\x69\x6c\x65\x73\x20\x74\x68\x61\x74\x20\x75\x73\x65\x20\x74\x68\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x65\x78\x74\x65\x6e\x73\x69\x6f\x6e\x2c\x20\x72\x65\x74\x75\x72\x6e\x69\x6e\x67\x20\x61\x0d\x0a\x2f\x2f\x20\x65\x6d\x70\x74\x79\x2f\x6e\x69\x6c\x20\x73\x6c\x69\x63\x65\x20\x69\x66\x20\x6e\x6f\x6e\x65\x20\x69\x73\x20\x66\x6f\x75\x6e\x64\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x46\x69\x6c\x65\x73\x46\x6f\x72\x28\x65\x78\x74\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x5b\x5d\x73\x74\x72\x69\x6e\x67\x20\x7b\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x73\x73\x65\x74\x73\x5b\x65\x78\x74\x5d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4d\x75\x73\x74\x46\x69\x6e\x64\x46\x69\x6c\x65\x20\x63\x61\x6c\x6c\x73\x20\x46\x69\x6e\x64\x46\x69\x6c\x65\x20\x74\x6f\x20\x72\x65\x74\x72\x69\x65\x76\x65\x20\x66\x69\x6c\x65\x20\x72\x65\x61\x64\x65\x72\x20\x77\x69\x74\x68\x20\x70\x61\x74\x68\x20\x65\x6c\x73\x65\x20\x70\x61\x6e\x69\x63\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4d\x75\x73\x74\x46\x69\x6e\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x20\x7b\x0d\x0a\x20\x20\x72\x65\x61\x64\x65\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x46\x69\x6e\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x61\x64\x65\x72\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x46\x69\x6e\x64\x46\x69\x6c\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x20\x62\x79\x20\x73\x65\x65\x6b\x69\x6e\x67\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x66\x69\x6c\x65\x20\x70\x61\x74\x68\x20\x69\x66\x20\x69\x74\x20\x65\x78\x69\x73\x74\x73\x2e\x0d\x0a\x2f\x2f\x20\x54\x68\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x69\x73\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x67\x7a\x69\x70\x70\x65\x64\x20\x61\x73\x20\x73\x74\x6f\x72\x65\x64\x20\x75\x6e\x6c\x65\x73\x73\x20\x64\x6f\x47\x7a\x69\x70\x20\x69\x73\x20\x73\x65\x74\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x46\x69\x6e\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x28\x69\x6f\x2e\x52\x65\x61\x64\x65\x72\x2c\x20\x65\x72\x72\x6f\x72\x29\x7b\x0d\x0a\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x79\x74\x65\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x62\x6f\x64\x79\x29\x2c\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4d\x75\x73\x74\x52\x65\x61\x64\x46\x69\x6c\x65\x20\x63\x61\x6c\x6c\x73\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x20\x74\x6f\x20\x72\x65\x74\x72\x69\x65\x76\x65\x20\x66\x69\x6c\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x77\x69\x74\x68\x20\x70\x61\x74\x68\x20\x65\x6c\x73\x65\x20\x70\x61\x6e\x69\x63\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4d\x75\x73\x74\x52\x65\x61\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x73\x74\x72\x69\x6e\x67\x20\x7b\x0d\x0a\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x6f\x64\x79\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x20\x61\x74\x74\x65\x6d\x70\x74\x73\x20\x74\x6f\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x65\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x20\x64\x61\x74\x61\x20\x61\x73\x73\x6f\x63\x69\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x70\x61\x74\x68\x0d\x0a\x2f\x2f\x20\x69\x66\x20\x69\x74\x20\x65\x78\x69\x73\x74\x73\x20\x65\x6c\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x6e\x20\x65\x72\x72\x6f\x72\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x65\x72\x72\x6f\x72\x29\x7b\x0d\x0a\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x73\x74\x72\x69\x6e\x67\x28\x62\x6f\x64\x79\x29\x2c\x20\x65\x72\x72\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4d\x75\x73\x74\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x20\x63\x61\x6c\x6c\x73\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x20\x74\x6f\x20\x72\x65\x74\x72\x69\x65\x76\x65\x20\x66\x69\x6c\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x77\x69\x74\x68\x20\x70\x61\x74\x68\x20\x65\x6c\x73\x65\x20\x70\x61\x6e\x69\x63\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4d\x75\x73\x74\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x5b\x5d\x62\x79\x74\x65\x20\x7b\x0d\x0a\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x70\x61\x74\x68\x2c\x20\x64\x6f\x47\x7a\x69\x70\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x6f\x64\x79\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x20\x61\x74\x74\x65\x6d\x70\x74\x73\x20\x74\x6f\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x65\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x20\x64\x61\x74\x61\x20\x61\x73\x73\x6f\x63\x69\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x70\x61\x74\x68\x0d\x0a\x2f\x2f\x20\x69\x66\x20\x69\x74\x20\x65\x78\x69\x73\x74\x73\x20\x65\x6c\x73\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x6e\x20\x65\x72\x72\x6f\x72\x2e\x20\x54\x68\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x69\x73\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x67\x7a\x69\x70\x70\x65\x64\x20\x61\x73\x20\x73\x74\x6f\x72\x65\x64\x0d\x0a\x2f\x2f\x20\x75\x6e\x6c\x65\x73\x73\x20\x64\x6f\x47\x7a\x69\x70\x20\x69\x73\x20\x73\x65\x74\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x6f\x47\x7a\x69\x70\x20\x62\x6f\x6f\x6c\x29\x20\x28\x5b\x5d\x62\x79\x74\x65\x2c\x20\x65\x72\x72\x6f\x72\x29\x7b\x0d\x0a\x20\x20\x69\x74\x65\x6d\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x73\x5b\x70\x61\x74\x68\x5d\x0d\x0a\x20\x20\x69\x66\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x46\x69\x6c\x65\x20\x25\x71\x20\x6e\x6f\x74\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x66\x69\x6c\x65\x20\x73\x79\x73\x74\x65\x6d\x22\x2c\x20\x70\x61\x74\x68\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x21\x64\x6f\x47\x7a\x69\x70\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x70\x70\x65\x6e\x64\x28\x5b\x5d\x62\x79\x74\x65\x28\x6e\x69\x6c\x29\x2c\x20\x69\x74\x65\x6d\x2e\x64\x61\x74\x61\x2e\x2e\x2e\x29\x2c\x20\x6e\x69\x6c\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x43\x6f\x6e\x74\x65\x6e\x74\x28\x70\x61\x74\x68\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x70\x70\x65\x6e\x64\x28\x5b\x5d\x62\x79\x74\x65\x28\x6e\x69\x6c\x29\x2c\x20\x62\x6f\x64\x79\x2e\x2e\x2e\x29\x2c\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x61\x73\x73\x65\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x75\x6e\x67\x7a\x69\x70\x70\x65\x64\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x61\x74\x20\x70\x61\x74\x68\x2c\x20\x77\x68\x69\x63\x68\x20\x69\x73\x0d\x0a\x2f\x2f\x20\x63\x61\x63\x68\x65\x64\x20\x61\x66\x74\x65\x72\x20\x74\x68\x65\x20\x66\x69\x72\x73\x74\x20\x72\x65\x61\x64\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x61\x73\x73\x65\x74\x43\x6f\x6e\x74\x65\x6e\x74\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x5b\x5d\x62\x79\x74\x65\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x52\x4c\x6f\x63\x6b\x28\x29\x0d\x0a\x09\x69\x66\x20\x64\x61\x74\x61\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x63\x61\x63\x68\x65\x5b\x70\x61\x74\x68\x5d\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x09\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x52\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x64\x61\x74\x61\x2c\x20\x6e\x69\x6c\x0d\x0a\x09\x7d\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x52\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x69\x74\x65\x6d\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x73\x5b\x70\x61\x74\x68\x5d\x0d\x0a\x20\x20\x69\x66\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x46\x69\x6c\x65\x20\x25\x71\x20\x6e\x6f\x74\x20\x66\x6f\x75\x6e\x64\x20\x69\x6e\x20\x66\x69\x6c\x65\x20\x73\x79\x73\x74\x65\x6d\x22\x2c\x20\x70\x61\x74\x68\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x61\x64\x65\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x67\x7a\x69\x70\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x62\x79\x74\x65\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x69\x74\x65\x6d\x2e\x64\x61\x74\x61\x29\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x46\x69\x6c\x65\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x62\x65\x20\x72\x65\x61\x64\x3a\x20\x25\x2b\x71\x22\x2c\x20\x70\x61\x74\x68\x2c\x20\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x64\x65\x66\x65\x72\x20\x72\x65\x61\x64\x65\x72\x2e\x43\x6c\x6f\x73\x65\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x76\x61\x72\x20\x62\x75\x20\x62\x79\x74\x65\x73\x2e\x42\x75\x66\x66\x65\x72\x0d\x0a\x0d\x0a\x20\x20\x5f\x2c\x20\x65\x72\x72\x20\x3d\x20\x69\x6f\x2e\x43\x6f\x70\x79\x28\x26\x62\x75\x2c\x20\x72\x65\x61\x64\x65\x72\x29\x3b\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x69\x6f\x2e\x45\x4f\x46\x20\x7b\x0d\x0a\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x66\x6d\x74\x2e\x45\x72\x72\x6f\x72\x66\x28\x22\x46\x69\x6c\x65\x20\x25\x71\x20\x66\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x62\x65\x20\x72\x65\x61\x64\x3a\x20\x25\x2b\x71\x22\x2c\x20\x70\x61\x74\x68\x2c\x20\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x4c\x6f\x63\x6b\x28\x29\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x63\x61\x63\x68\x65\x5b\x70\x61\x74\x68\x5d\x20\x3d\x20\x62\x75\x2e\x42\x79\x74\x65\x73\x28\x29\x0d\x0a\x09\x61\x73\x73\x65\x74\x73\x43\x61\x63\x68\x65\x2e\x6d\x6c\x2e\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x75\x2e\x42\x79\x74\x65\x73\x28\x29\x2c\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4d\x61\x6e\x69\x66\x65\x73\x74\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x6d\x61\x70\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x61\x74\x68\x73\x20\x6f\x66\x20\x61\x6c\x6c\x20\x66\x69\x6c\x65\x73\x20\x74\x6f\x20\x74\x68\x65\x69\x72\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x65\x64\x20\x70\x61\x74\x68\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4d\x61\x6e\x69\x66\x65\x73\x74\x28\x29\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x73\x74\x72\x69\x6e\x67\x20\x7b\x0d\x0a\x20\x20\x6d\x61\x6e\x69\x66\x65\x73\x74\x20\x3a\x3d\x20\x6d\x61\x6b\x65\x28\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x73\x74\x72\x69\x6e\x67\x2c\x20\x6c\x65\x6e\x28\x61\x73\x73\x65\x74\x46\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x73\x29\x29\x0d\x0a\x20\x20\x66\x6f\x72\x20\x70\x61\x74\x68\x2c\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x61\x73\x73\x65\x74\x46\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x73\x20\x7b\x0d\x0a\x20\x20\x20\x20\x6d\x61\x6e\x69\x66\x65\x73\x74\x5b\x70\x61\x74\x68\x5d\x20\x3d\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6d\x61\x6e\x69\x66\x65\x73\x74\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x46\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x46\x6f\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x65\x64\x20\x70\x61\x74\x68\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x61\x74\x20\x70\x61\x74\x68\x2c\x20\x77\x68\x69\x63\x68\x0d\x0a\x2f\x2f\x20\x63\x6f\x6e\x74\x61\x69\x6e\x73\x20\x74\x68\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x68\x61\x73\x68\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x2c\x20\x65\x67\x2e\x20\x22\x6a\x73\x2f\x61\x70\x70\x2e\x35\x64\x34\x31\x34\x30\x32\x61\x62\x63\x34\x62\x2e\x6a\x73\x22\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x46\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x46\x6f\x72\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x62\x6f\x6f\x6c\x29\x20\x7b\x0d\x0a\x20\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x46\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x73\x5b\x70\x61\x74\x68\x5d\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x2c\x20\x6f\x6b\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x50\x61\x74\x68\x46\x6f\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x70\x61\x74\x68\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x65\x64\x20\x70\x61\x74\x68\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x50\x61\x74\x68\x46\x6f\x72\x28\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x62\x6f\x6f\x6c\x29\x20\x7b\x0d\x0a\x20\x20\x70\x61\x74\x68\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x50\x61\x74\x68\x73\x5b\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x5d\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x70\x61\x74\x68\x2c\x20\x6f\x6b\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x48\x61\x73\x68\x46\x6f\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x68\x61\x73\x68\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x61\x74\x20\x70\x61\x74\x68\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x48\x61\x73\x68\x46\x6f\x72\x28\x70\x61\x74\x68\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x73\x74\x72\x69\x6e\x67\x2c\x20\x62\x6f\x6f\x6c\x29\x20\x7b\x0d\x0a\x20\x20\x68\x61\x73\x68\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x48\x61\x73\x68\x65\x73\x5b\x70\x61\x74\x68\x5d\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x68\x61\x73\x68\x2c\x20\x6f\x6b\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x48\x61\x6e\x64\x6c\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x68\x74\x74\x70\x2e\x48\x61\x6e\x64\x6c\x65\x72\x20\x77\x68\x69\x63\x68\x20\x73\x65\x72\x76\x65\x73\x20\x66\x69\x6c\x65\x73\x20\x62\x79\x20\x74\x68\x65\x69\x72\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x65\x64\x20\x70\x61\x74\x68\x73\x0d\x0a\x2f\x2f\x20\x77\x69\x74\x68\x20\x68\x65\x61\x64\x65\x72\x73\x20\x74\x6f\x20\x63\x61\x63\x68\x65\x20\x74\x68\x65\x6d\x20\x69\x6e\x64\x65\x66\x69\x6e\x69\x74\x65\x6c\x79\x2c\x20\x61\x6e\x64\x20\x62\x79\x20\x74\x68\x65\x69\x72\x20\x70\x61\x74\x68\x73\x20\x77\x69\x74\x68\x20\x68\x65\x61\x64\x65\x72\x73\x20\x74\x6f\x0d\x0a\x2f\x2f\x20\x72\x65\x76\x61\x6c\x69\x64\x61\x74\x65\x20\x74\x68\x65\x6d\x20\x62\x79\x20\x74\x68\x65\x69\x72\x20\x45\x54\x61\x67\x2e\x20\x46\x69\x6c\x65\x73\x20\x61\x72\x65\x20\x73\x65\x72\x76\x65\x64\x20\x67\x7a\x69\x70\x70\x65\x64\x20\x61\x73\x20\x73\x74\x6f\x72\x65\x64\x20\x74\x6f\x20\x63\x6c\x69\x65\x6e\x74\x73\x0d\x0a\x2f\x2f\x20\x77\x68\x69\x63\x68\x20\x61\x63\x63\x65\x70\x74\x20\x69\x74\x2c\x20\x65\x78\x63\x65\x70\x74\x20\x66\x6f\x72\x20\x52\x61\x6e\x67\x65\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x77\x68\x69\x63\x68\x20\x61\x72\x65\x20\x73\x65\x72\x76\x65\x64\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x2e\x0d\x0a\x2f\x2f\x20\x55\x73\x65\x20\x68\x74\x74\x70\x2e\x53\x74\x72\x69\x70\x50\x72\x65\x66\x69\x78\x20\x74\x6f\x20\x73\x65\x72\x76\x65\x20\x66\x69\x6c\x65\x73\x20\x75\x6e\x64\x65\x72\x20\x61\x20\x70\x72\x65\x66\x69\x78\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x48\x61\x6e\x64\x6c\x65\x72\x28\x29\x20\x68\x74\x74\x70\x2e\x48\x61\x6e\x64\x6c\x65\x72\x20\x7b\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x73\x73\x65\x74\x73\x48\x61\x6e\x64\x6c\x65\x72\x7b\x7d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x61\x73\x73\x65\x74\x73\x48\x61\x6e\x64\x6c\x65\x72\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x73\x20\x74\x68\x65\x20\x68\x74\x74\x70\x2e\x48\x61\x6e\x64\x6c\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x65\x64\x20\x62\x79\x20\x48\x61\x6e\x64\x6c\x65\x72\x2e\x0d\x0a\x74\x79\x70\x65\x20\x61\x73\x73\x65\x74\x73\x48\x61\x6e\x64\x6c\x65\x72\x20\x73\x74\x72\x75\x63\x74\x7b\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x53\x65\x72\x76\x65\x48\x54\x54\x50\x20\x73\x65\x72\x76\x65\x73\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x61\x74\x20\x74\x68\x65\x20\x70\x61\x74\x68\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x65\x71\x75\x65\x73\x74\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x61\x73\x73\x65\x74\x73\x48\x61\x6e\x64\x6c\x65\x72\x29\x20\x53\x65\x72\x76\x65\x48\x54\x54\x50\x28\x77\x20\x68\x74\x74\x70\x2e\x52\x65\x73\x70\x6f\x6e\x73\x65\x57\x72\x69\x74\x65\x72\x2c\x20\x72\x20\x2a\x68\x74\x74\x70\x2e\x52\x65\x71\x75\x65\x73\x74\x29\x20\x7b\x0d\x0a\x20\x20\x69\x66\x20\x72\x2e\x4d\x65\x74\x68\x6f\x64\x20\x21\x3d\x20\x68\x74\x74\x70\x2e\x4d\x65\x74\x68\x6f\x64\x47\x65\x74\x20\x26\x26\x20\x72\x2e\x4d\x65\x74\x68\x6f\x64\x20\x21\x3d\x20\x68\x74\x74\x70\x2e\x4d\x65\x74\x68\x6f\x64\x48\x65\x61\x64\x20\x7b\x0d\x0a\x20\x20\x20\x20\x77\x2e\x48\x65\x61\x64\x65\x72\x28\x29\x2e\x53\x65\x74\x28\x22\x41\x6c\x6c\x6f\x77\x22\x2c\x20\x22\x47\x45\x54\x2c\x20\x48\x45\x41\x44\x22\x29\x0d\x0a\x20\x20\x20\x20\x68\x74\x74\x70\x2e\x45\x72\x72\x6f\x72\x28\x77\x2c\x20\x68\x74\x74\x70\x2e\x53\x74\x61\x74\x75\x73\x54\x65\x78\x74\x28\x68\x74\x74\x70\x2e\x53\x74\x61\x74\x75\x73\x4d\x65\x74\x68\x6f\x64\x4e\x6f\x74\x41\x6c\x6c\x6f\x77\x65\x64\x29\x2c\x20\x68\x74\x74\x70\x2e\x53\x74\x61\x74\x75\x73\x4d\x65\x74\x68\x6f\x64\x4e\x6f\x74\x41\x6c\x6c\x6f\x77\x65\x64\x29\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x6e\x61\x6d\x65\x20\x3a\x3d\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x28\x72\x2e\x55\x52\x4c\x2e\x50\x61\x74\x68\x2c\x20\x22\x2f\x22\x29\x0d\x0a\x20\x20\x63\x61\x63\x68\x65\x43\x6f\x6e\x74\x72\x6f\x6c\x20\x3a\x3d\x20\x22\x6e\x6f\x2d\x63\x61\x63\x68\x65\x22\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x6f\x72\x69\x67\x69\x6e\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x50\x61\x74\x68\x73\x5b\x6e\x61\x6d\x65\x5d\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x6e\x61\x6d\x65\x20\x3d\x20\x6f\x72\x69\x67\x69\x6e\x0d\x0a\x20\x20\x20\x20\x63\x61\x63\x68\x65\x43\x6f\x6e\x74\x72\x6f\x6c\x20\x3d\x20\x22\x70\x75\x62\x6c\x69\x63\x2c\x20\x6d\x61\x78\x2d\x61\x67\x65\x3d\x33\x31\x35\x33\x36\x30\x30\x30\x2c\x20\x69\x6d\x6d\x75\x74\x61\x62\x6c\x65\x22\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x68\x61\x73\x68\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x48\x61\x73\x68\x65\x73\x5b\x6e\x61\x6d\x65\x5d\x0d\x0a\x20\x20\x69\x66\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x68\x74\x74\x70\x2e\x4e\x6f\x74\x46\x6f\x75\x6e\x64\x28\x77\x2c\x20\x72\x29\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x68\x65\x61\x64\x65\x72\x20\x3a\x3d\x20\x77\x2e\x48\x65\x61\x64\x65\x72\x28\x29\x0d\x0a\x20\x20\x68\x65\x61\x64\x65\x72\x2e\x53\x65\x74\x28\x22\x43\x61\x63\x68\x65\x2d\x43\x6f\x6e\x74\x72\x6f\x6c\x22\x2c\x20\x63\x61\x63\x68\x65\x43\x6f\x6e\x74\x72\x6f\x6c\x29\x0d\x0a\x20\x20\x68\x65\x61\x64\x65\x72\x2e\x53\x65\x74\x28\x22\x56\x61\x72\x79\x22\x2c\x20\x22\x41\x63\x63\x65\x70\x74\x2d\x45\x6e\x63\x6f\x64\x69\x6e\x67\x22\x29\x0d\x0a\x0d\x0a\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x20\x3a\x3d\x20\x6d\x69\x6d\x65\x2e\x54\x79\x70\x65\x42\x79\x45\x78\x74\x65\x6e\x73\x69\x6f\x6e\x28\x70\x61\x74\x68\x2e\x45\x78\x74\x28\x6e\x61\x6d\x65\x29\x29\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x72\x2e\x48\x65\x61\x64\x65\x72\x2e\x47\x65\x74\x28\x22\x52\x61\x6e\x67\x65\x22\x29\x20\x3d\x3d\x20\x22\x22\x20\x26\x26\x20\x61\x73\x73\x65\x74\x41\x63\x63\x65\x70\x74\x73\x47\x7a\x69\x70\x28\x72\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x20\x3d\x3d\x20\x22\x22\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x43\x6f\x6e\x74\x65\x6e\x74\x28\x6e\x61\x6d\x65\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x74\x74\x70\x2e\x45\x72\x72\x6f\x72\x28\x77\x2c\x20\x65\x72\x72\x2e\x45\x72\x72\x6f\x72\x28\x29\x2c\x20\x68\x74\x74\x70\x2e\x53\x74\x61\x74\x75\x73\x49\x6e\x74\x65\x72\x6e\x61\x6c\x53\x65\x72\x76\x65\x72\x45\x72\x72\x6f\x72\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x20\x3d\x20\x68\x74\x74\x70\x2e\x44\x65\x74\x65\x63\x74\x43\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x28\x62\x6f\x64\x79\x29\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x2f\x2f\x20\x45\x61\x63\x68\x20\x65\x6e\x63\x6f\x64\x69\x6e\x67\x20\x6f\x66\x20\x61\x20\x66\x69\x6c\x65\x20\x69\x73\x20\x67\x69\x76\x65\x6e\x20\x69\x74\x27\x73\x20\x6f\x77\x6e\x20\x45\x54\x61\x67\x2e\x0d\x0a\x20\x20\x20\x20\x68\x65\x61\x64\x65\x72\x2e\x53\x65\x74\x28\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x22\x2c\x20\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x29\x0d\x0a\x20\x20\x20\x20\x68\x65\x61\x64\x65\x72\x2e\x53\x65\x74\x28\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x45\x6e\x63\x6f\x64\x69\x6e\x67\x22\x2c\x20\x22\x67\x7a\x69\x70\x22\x29\x0d\x0a\x20\x20\x20\x20\x68\x65\x61\x64\x65\x72\x2e\x53\x65\x74\x28\x22\x45\x54\x61\x67\x22\x2c\x20\x73\x74\x72\x63\x6f\x6e\x76\x2e\x51\x75\x6f\x74\x65\x28\x68\x61\x73\x68\x2b\x22\x2d\x67\x7a\x69\x70\x22\x29\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x68\x74\x74\x70\x2e\x53\x65\x72\x76\x65\x43\x6f\x6e\x74\x65\x6e\x74\x28\x77\x2c\x20\x72\x2c\x20\x6e\x61\x6d\x65\x2c\x20\x74\x69\x6d\x65\x2e\x54\x69\x6d\x65\x7b\x7d\x2c\x20\x62\x79\x74\x65\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x73\x5b\x6e\x61\x6d\x65\x5d\x2e\x64\x61\x74\x61\x29\x29\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x43\x6f\x6e\x74\x65\x6e\x74\x28\x6e\x61\x6d\x65\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x68\x74\x74\x70\x2e\x45\x72\x72\x6f\x72\x28\x77\x2c\x20\x65\x72\x72\x2e\x45\x72\x72\x6f\x72\x28\x29\x2c\x20\x68\x74\x74\x70\x2e\x53\x74\x61\x74\x75\x73\x49\x6e\x74\x65\x72\x6e\x61\x6c\x53\x65\x72\x76\x65\x72\x45\x72\x72\x6f\x72\x29\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x20\x21\x3d\x20\x22\x22\x20\x7b\x0d\x0a\x20\x20\x20\x20\x68\x65\x61\x64\x65\x72\x2e\x53\x65\x74\x28\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x22\x2c\x20\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x68\x65\x61\x64\x65\x72\x2e\x53\x65\x74\x28\x22\x45\x54\x61\x67\x22\x2c\x20\x73\x74\x72\x63\x6f\x6e\x76\x2e\x51\x75\x6f\x74\x65\x28\x68\x61\x73\x68\x29\x29\x0d\x0a\x0d\x0a\x20\x20\x68\x74\x74\x70\x2e\x53\x65\x72\x76\x65\x43\x6f\x6e\x74\x65\x6e\x74\x28\x77\x2c\x20\x72\x2c\x20\x6e\x61\x6d\x65\x2c\x20\x74\x69\x6d\x65\x2e\x54\x69\x6d\x65\x7b\x7d\x2c\x20\x62\x79\x74\x65\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x62\x6f\x64\x79\x29\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x61\x73\x73\x65\x74\x41\x63\x63\x65\x70\x74\x73\x47\x7a\x69\x70\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x72\x75\x65\x20\x69\x66\x20\x74\x68\x65\x20\x41\x63\x63\x65\x70\x74\x2d\x45\x6e\x63\x6f\x64\x69\x6e\x67\x20\x68\x65\x61\x64\x65\x72\x20\x6f\x66\x20\x74\x68\x65\x20\x72\x65\x71\x75\x65\x73\x74\x0d\x0a\x2f\x2f\x20\x61\x63\x63\x65\x70\x74\x73\x20\x67\x7a\x69\x70\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x61\x73\x73\x65\x74\x41\x63\x63\x65\x70\x74\x73\x47\x7a\x69\x70\x28\x72\x20\x2a\x68\x74\x74\x70\x2e\x52\x65\x71\x75\x65\x73\x74\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x0d\x0a\x20\x20\x66\x6f\x72\x20\x5f\x2c\x20\x69\x74\x65\x6d\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x53\x70\x6c\x69\x74\x28\x72\x2e\x48\x65\x61\x64\x65\x72\x2e\x47\x65\x74\x28\x22\x41\x63\x63\x65\x70\x74\x2d\x45\x6e\x63\x6f\x64\x69\x6e\x67\x22\x29\x2c\x20\x22\x2c\x22\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x63\x6f\x64\x69\x6e\x67\x2c\x20\x70\x61\x72\x61\x6d\x73\x20\x3a\x3d\x20\x69\x74\x65\x6d\x2c\x20\x22\x22\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x69\x6e\x64\x65\x78\x20\x3a\x3d\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x49\x6e\x64\x65\x78\x28\x69\x74\x65\x6d\x2c\x20\x22\x3b\x22\x29\x3b\x20\x69\x6e\x64\x65\x78\x20\x21\x3d\x20\x2d\x31\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x63\x6f\x64\x69\x6e\x67\x2c\x20\x70\x61\x72\x61\x6d\x73\x20\x3d\x20\x69\x74\x65\x6d\x5b\x3a\x69\x6e\x64\x65\x78\x5d\x2c\x20\x69\x74\x65\x6d\x5b\x69\x6e\x64\x65\x78\x2b\x31\x3a\x5d\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x63\x6f\x64\x69\x6e\x67\x20\x3d\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x72\x69\x6d\x53\x70\x61\x63\x65\x28\x63\x6f\x64\x69\x6e\x67\x29\x3b\x20\x63\x6f\x64\x69\x6e\x67\x20\x21\x3d\x20\x22\x67\x7a\x69\x70\x22\x20\x26\x26\x20\x63\x6f\x64\x69\x6e\x67\x20\x21\x3d\x20\x22\x2a\x22\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x69\x6e\x75\x65\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x71\x75\x61\x6c\x69\x74\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x73\x74\x72\x63\x6f\x6e\x76\x2e\x50\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x28\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x72\x69\x6d\x53\x70\x61\x63\x65\x28\x70\x61\x72\x61\x6d\x73\x29\x2c\x20\x22\x71\x3d\x22\x29\x2c\x20\x36\x34\x29\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7c\x7c\x20\x71\x75\x61\x6c\x69\x74\x79\x20\x3e\x20\x30\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x46\x53\x20\x63\x6f\x6e\x74\x61\x69\x6e\x73\x20\x74\x68\x65\x20\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x73\x2c\x20\x65\x67\x2e\x20\x66\x6f\x72\x20\x75\x73\x65\x20\x77\x69\x74\x68\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x2e\x50\x61\x72\x73\x65\x46\x53\x2e\x0d\x0a\x76\x61\x72\x20\x46\x53\x20\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x48\x54\x54\x50\x46\x53\x20\x63\x6f\x6e\x74\x61\x69\x6e\x73\x20\x74\x68\x65\x20\x48\x54\x54\x50\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x73\x2c\x20\x65\x67\x2e\x20\x66\x6f\x72\x20\x75\x73\x65\x20\x77\x69\x74\x68\x0d\x0a\x2f\x2f\x20\x68\x74\x74\x70\x2e\x46\x69\x6c\x65\x53\x65\x72\x76\x65\x72\x2e\x0d\x0a\x76\x61\x72\x20\x48\x54\x54\x50\x46\x53\x20\x48\x54\x54\x50\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x73\x20\x66\x73\x2e\x46\x53\x2c\x20\x66\x73\x2e\x52\x65\x61\x64\x44\x69\x72\x46\x53\x20\x61\x6e\x64\x20\x66\x73\x2e\x52\x65\x61\x64\x46\x69\x6c\x65\x46\x53\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x73\x2c\x0d\x0a\x2f\x2f\x20\x77\x68\x69\x63\x68\x20\x61\x72\x65\x20\x6f\x70\x65\x6e\x65\x64\x20\x62\x79\x20\x74\x68\x65\x69\x72\x20\x70\x61\x74\x68\x73\x20\x6f\x72\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x65\x64\x20\x70\x61\x74\x68\x73\x2e\x0d\x0a\x74\x79\x70\x65\x20\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x20\x73\x74\x72\x75\x63\x74\x7b\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4f\x70\x65\x6e\x20\x6f\x70\x65\x6e\x73\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x6f\x72\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6e\x61\x6d\x65\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x29\x20\x4f\x70\x65\x6e\x28\x6e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x66\x73\x2e\x46\x69\x6c\x65\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x66\x69\x6c\x65\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x70\x65\x6e\x41\x73\x73\x65\x74\x28\x6e\x61\x6d\x65\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x26\x66\x73\x2e\x50\x61\x74\x68\x45\x72\x72\x6f\x72\x7b\x4f\x70\x3a\x20\x22\x6f\x70\x65\x6e\x22\x2c\x20\x50\x61\x74\x68\x3a\x20\x6e\x61\x6d\x65\x2c\x20\x45\x72\x72\x3a\x20\x65\x72\x72\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x69\x6c\x65\x2c\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x61\x64\x44\x69\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6e\x61\x6d\x65\x2c\x20\x73\x6f\x72\x74\x65\x64\x20\x62\x79\x20\x74\x68\x65\x69\x72\x0d\x0a\x2f\x2f\x20\x6e\x61\x6d\x65\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x29\x20\x52\x65\x61\x64\x44\x69\x72\x28\x6e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x5b\x5d\x66\x73\x2e\x44\x69\x72\x45\x6e\x74\x72\x79\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x69\x66\x20\x21\x66\x73\x2e\x56\x61\x6c\x69\x64\x50\x61\x74\x68\x28\x6e\x61\x6d\x65\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x26\x66\x73\x2e\x50\x61\x74\x68\x45\x72\x72\x6f\x72\x7b\x4f\x70\x3a\x20\x22\x72\x65\x61\x64\x64\x69\x72\x22\x2c\x20\x50\x61\x74\x68\x3a\x20\x6e\x61\x6d\x65\x2c\x20\x45\x72\x72\x3a\x20\x66\x73\x2e\x45\x72\x72\x49\x6e\x76\x61\x6c\x69\x64\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x65\x6e\x74\x72\x69\x65\x73\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x44\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x28\x6e\x61\x6d\x65\x29\x0d\x0a\x20\x20\x69\x66\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x26\x66\x73\x2e\x50\x61\x74\x68\x45\x72\x72\x6f\x72\x7b\x4f\x70\x3a\x20\x22\x72\x65\x61\x64\x64\x69\x72\x22\x2c\x20\x50\x61\x74\x68\x3a\x20\x6e\x61\x6d\x65\x2c\x20\x45\x72\x72\x3a\x20\x66\x73\x2e\x45\x72\x72\x4e\x6f\x74\x45\x78\x69\x73\x74\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x64\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x20\x3a\x3d\x20\x6d\x61\x6b\x65\x28\x5b\x5d\x66\x73\x2e\x44\x69\x72\x45\x6e\x74\x72\x79\x2c\x20\x6c\x65\x6e\x28\x65\x6e\x74\x72\x69\x65\x73\x29\x29\x0d\x0a\x20\x20\x66\x6f\x72\x20\x69\x6e\x64\x65\x78\x2c\x20\x65\x6e\x74\x72\x79\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x7b\x0d\x0a\x20\x20\x20\x20\x64\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x5b\x69\x6e\x64\x65\x78\x5d\x20\x3d\x20\x65\x6e\x74\x72\x79\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x64\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x2c\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6e\x61\x6d\x65\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x29\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x28\x6e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x5b\x5d\x62\x79\x74\x65\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x69\x66\x20\x21\x66\x73\x2e\x56\x61\x6c\x69\x64\x50\x61\x74\x68\x28\x6e\x61\x6d\x65\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x26\x66\x73\x2e\x50\x61\x74\x68\x45\x72\x72\x6f\x72\x7b\x4f\x70\x3a\x20\x22\x72\x65\x61\x64\x22\x2c\x20\x50\x61\x74\x68\x3a\x20\x6e\x61\x6d\x65\x2c\x20\x45\x72\x72\x3a\x20\x66\x73\x2e\x45\x72\x72\x49\x6e\x76\x61\x6c\x69\x64\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x6f\x72\x69\x67\x69\x6e\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x50\x61\x74\x68\x73\x5b\x6e\x61\x6d\x65\x5d\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x6e\x61\x6d\x65\x20\x3d\x20\x6f\x72\x69\x67\x69\x6e\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x5f\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x73\x5b\x6e\x61\x6d\x65\x5d\x3b\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x26\x66\x73\x2e\x50\x61\x74\x68\x45\x72\x72\x6f\x72\x7b\x4f\x70\x3a\x20\x22\x72\x65\x61\x64\x22\x2c\x20\x50\x61\x74\x68\x3a\x20\x6e\x61\x6d\x65\x2c\x20\x45\x72\x72\x3a\x20\x66\x73\x2e\x45\x72\x72\x4e\x6f\x74\x45\x78\x69\x73\x74\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x52\x65\x61\x64\x46\x69\x6c\x65\x42\x79\x74\x65\x28\x6e\x61\x6d\x65\x2c\x20\x74\x72\x75\x65\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x48\x54\x54\x50\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x73\x20\x68\x74\x74\x70\x2e\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x73\x2c\x20\x77\x68\x69\x63\x68\x20\x61\x72\x65\x20\x6f\x70\x65\x6e\x65\x64\x20\x62\x79\x0d\x0a\x2f\x2f\x20\x74\x68\x65\x69\x72\x20\x70\x61\x74\x68\x73\x20\x6f\x72\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x65\x64\x20\x70\x61\x74\x68\x73\x2e\x0d\x0a\x74\x79\x70\x65\x20\x48\x54\x54\x50\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x20\x73\x74\x72\x75\x63\x74\x7b\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4f\x70\x65\x6e\x20\x6f\x70\x65\x6e\x73\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x6f\x72\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6e\x61\x6d\x65\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x48\x54\x54\x50\x46\x69\x6c\x65\x53\x79\x73\x74\x65\x6d\x29\x20\x4f\x70\x65\x6e\x28\x6e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x68\x74\x74\x70\x2e\x46\x69\x6c\x65\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x6e\x61\x6d\x65\x20\x3d\x20\x73\x74\x72\x69\x6e\x67\x73\x2e\x54\x72\x69\x6d\x50\x72\x65\x66\x69\x78\x28\x70\x61\x74\x68\x2e\x43\x6c\x65\x61\x6e\x28\x22\x2f\x22\x2b\x6e\x61\x6d\x65\x29\x2c\x20\x22\x2f\x22\x29\x0d\x0a\x20\x20\x69\x66\x20\x6e\x61\x6d\x65\x20\x3d\x3d\x20\x22\x22\x20\x7b\x0d\x0a\x20\x20\x20\x20\x6e\x61\x6d\x65\x20\x3d\x20\x22\x2e\x22\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x66\x69\x6c\x65\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x70\x65\x6e\x41\x73\x73\x65\x74\x28\x6e\x61\x6d\x65\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x26\x66\x73\x2e\x50\x61\x74\x68\x45\x72\x72\x6f\x72\x7b\x4f\x70\x3a\x20\x22\x6f\x70\x65\x6e\x22\x2c\x20\x50\x61\x74\x68\x3a\x20\x6e\x61\x6d\x65\x2c\x20\x45\x72\x72\x3a\x20\x65\x72\x72\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x69\x6c\x65\x2c\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x6f\x70\x65\x6e\x41\x73\x73\x65\x74\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x6f\x72\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6e\x61\x6d\x65\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x6f\x70\x65\x6e\x41\x73\x73\x65\x74\x28\x6e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x68\x74\x74\x70\x2e\x46\x69\x6c\x65\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x69\x66\x20\x21\x66\x73\x2e\x56\x61\x6c\x69\x64\x50\x61\x74\x68\x28\x6e\x61\x6d\x65\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x66\x73\x2e\x45\x72\x72\x49\x6e\x76\x61\x6c\x69\x64\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x6f\x72\x69\x67\x69\x6e\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x50\x61\x74\x68\x73\x5b\x6e\x61\x6d\x65\x5d\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x6e\x61\x6d\x65\x20\x3d\x20\x6f\x72\x69\x67\x69\x6e\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x5f\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x73\x5b\x6e\x61\x6d\x65\x5d\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x62\x6f\x64\x79\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x43\x6f\x6e\x74\x65\x6e\x74\x28\x6e\x61\x6d\x65\x29\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x65\x72\x72\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x26\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x52\x65\x61\x64\x65\x72\x3a\x20\x62\x79\x74\x65\x73\x2e\x4e\x65\x77\x52\x65\x61\x64\x65\x72\x28\x62\x6f\x64\x79\x29\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x69\x6e\x66\x6f\x3a\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x7b\x6e\x61\x6d\x65\x3a\x20\x70\x61\x74\x68\x2e\x42\x61\x73\x65\x28\x6e\x61\x6d\x65\x29\x2c\x20\x73\x69\x7a\x65\x3a\x20\x69\x6e\x74\x36\x34\x28\x6c\x65\x6e\x28\x62\x6f\x64\x79\x29\x29\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x7d\x2c\x20\x6e\x69\x6c\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x65\x6e\x74\x72\x69\x65\x73\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x44\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x28\x6e\x61\x6d\x65\x29\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x26\x61\x73\x73\x65\x74\x44\x69\x72\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x69\x6e\x66\x6f\x3a\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x7b\x6e\x61\x6d\x65\x3a\x20\x70\x61\x74\x68\x2e\x42\x61\x73\x65\x28\x6e\x61\x6d\x65\x29\x2c\x20\x64\x69\x72\x3a\x20\x74\x72\x75\x65\x7d\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x65\x6e\x74\x72\x69\x65\x73\x3a\x20\x65\x6e\x74\x72\x69\x65\x73\x2c\x0d\x0a\x20\x20\x20\x20\x7d\x2c\x20\x6e\x69\x6c\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x66\x73\x2e\x45\x72\x72\x4e\x6f\x74\x45\x78\x69\x73\x74\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x61\x73\x73\x65\x74\x44\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6e\x61\x6d\x65\x2c\x20\x77\x68\x65\x72\x65\x0d\x0a\x2f\x2f\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x69\x65\x73\x20\x61\x72\x65\x20\x74\x68\x6f\x73\x65\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x61\x74\x68\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x61\x73\x73\x65\x74\x44\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x28\x6e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x28\x5b\x5d\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x2c\x20\x62\x6f\x6f\x6c\x29\x20\x7b\x0d\x0a\x20\x20\x61\x73\x73\x65\x74\x44\x69\x72\x73\x2e\x6f\x6e\x63\x65\x2e\x44\x6f\x28\x66\x75\x6e\x63\x28\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x64\x69\x72\x73\x20\x3a\x3d\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x7b\x22\x2e\x22\x3a\x20\x7b\x7d\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x66\x6f\x72\x20\x66\x69\x6c\x65\x2c\x20\x69\x74\x65\x6d\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x73\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x72\x65\x6e\x74\x20\x3a\x3d\x20\x70\x61\x74\x68\x2e\x44\x69\x72\x28\x66\x69\x6c\x65\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x63\x68\x69\x6c\x64\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x7b\x6e\x61\x6d\x65\x3a\x20\x70\x61\x74\x68\x2e\x42\x61\x73\x65\x28\x66\x69\x6c\x65\x29\x2c\x20\x73\x69\x7a\x65\x3a\x20\x69\x74\x65\x6d\x2e\x73\x69\x7a\x65\x28\x29\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x64\x69\x72\x73\x5b\x70\x61\x72\x65\x6e\x74\x5d\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x72\x73\x5b\x70\x61\x72\x65\x6e\x74\x5d\x20\x3d\x20\x6d\x61\x6b\x65\x28\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x72\x73\x5b\x70\x61\x72\x65\x6e\x74\x5d\x5b\x63\x68\x69\x6c\x64\x2e\x6e\x61\x6d\x65\x5d\x20\x3d\x20\x63\x68\x69\x6c\x64\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x70\x61\x72\x65\x6e\x74\x20\x3d\x3d\x20\x22\x2e\x22\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x62\x72\x65\x61\x6b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x68\x69\x6c\x64\x20\x3d\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x7b\x6e\x61\x6d\x65\x3a\x20\x70\x61\x74\x68\x2e\x42\x61\x73\x65\x28\x70\x61\x72\x65\x6e\x74\x29\x2c\x20\x64\x69\x72\x3a\x20\x74\x72\x75\x65\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x72\x65\x6e\x74\x20\x3d\x20\x70\x61\x74\x68\x2e\x44\x69\x72\x28\x70\x61\x72\x65\x6e\x74\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x61\x73\x73\x65\x74\x44\x69\x72\x73\x2e\x65\x6e\x74\x72\x69\x65\x73\x20\x3d\x20\x6d\x61\x6b\x65\x28\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x5b\x5d\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x2c\x20\x6c\x65\x6e\x28\x64\x69\x72\x73\x29\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x66\x6f\x72\x20\x64\x69\x72\x2c\x20\x63\x68\x69\x6c\x64\x72\x65\x6e\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x64\x69\x72\x73\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x3a\x3d\x20\x6d\x61\x6b\x65\x28\x5b\x5d\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x2c\x20\x30\x2c\x20\x6c\x65\x6e\x28\x63\x68\x69\x6c\x64\x72\x65\x6e\x29\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x5f\x2c\x20\x63\x68\x69\x6c\x64\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x63\x68\x69\x6c\x64\x72\x65\x6e\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x65\x6e\x74\x72\x69\x65\x73\x2c\x20\x63\x68\x69\x6c\x64\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x73\x6f\x72\x74\x2e\x53\x6c\x69\x63\x65\x28\x65\x6e\x74\x72\x69\x65\x73\x2c\x20\x66\x75\x6e\x63\x28\x69\x2c\x20\x6a\x20\x69\x6e\x74\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x6e\x74\x72\x69\x65\x73\x5b\x69\x5d\x2e\x6e\x61\x6d\x65\x20\x3c\x20\x65\x6e\x74\x72\x69\x65\x73\x5b\x6a\x5d\x2e\x6e\x61\x6d\x65\x0d\x0a\x20\x20\x20\x20\x20\x20\x7d\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x61\x73\x73\x65\x74\x44\x69\x72\x73\x2e\x65\x6e\x74\x72\x69\x65\x73\x5b\x64\x69\x72\x5d\x20\x3d\x20\x65\x6e\x74\x72\x69\x65\x73\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x7d\x29\x0d\x0a\x0d\x0a\x20\x20\x65\x6e\x74\x72\x69\x65\x73\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x44\x69\x72\x73\x2e\x65\x6e\x74\x72\x69\x65\x73\x5b\x6e\x61\x6d\x65\x5d\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x6e\x74\x72\x69\x65\x73\x2c\x20\x6f\x6b\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x73\x20\x66\x73\x2e\x46\x69\x6c\x65\x49\x6e\x66\x6f\x20\x61\x6e\x64\x20\x66\x73\x2e\x44\x69\x72\x45\x6e\x74\x72\x79\x20\x66\x6f\x72\x20\x66\x69\x6c\x65\x73\x20\x61\x6e\x64\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x69\x65\x73\x2e\x0d\x0a\x74\x79\x70\x65\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x20\x73\x74\x72\x75\x63\x74\x20\x7b\x0d\x0a\x20\x20\x6e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x0d\x0a\x20\x20\x73\x69\x7a\x65\x20\x69\x6e\x74\x36\x34\x0d\x0a\x20\x20\x64\x69\x72\x20\x62\x6f\x6f\x6c\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x66\x75\x6e\x63\x20\x28\x61\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x29\x20\x4e\x61\x6d\x65\x28\x29\x20\x73\x74\x72\x69\x6e\x67\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6e\x61\x6d\x65\x20\x7d\x0d\x0a\x66\x75\x6e\x63\x20\x28\x61\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x29\x20\x53\x69\x7a\x65\x28\x29\x20\x69\x6e\x74\x36\x34\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x73\x69\x7a\x65\x20\x7d\x0d\x0a\x66\x75\x6e\x63\x20\x28\x61\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x29\x20\x4d\x6f\x64\x54\x69\x6d\x65\x28\x29\x20\x74\x69\x6d\x65\x2e\x54\x69\x6d\x65\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x69\x6d\x65\x2e\x54\x69\x6d\x65\x7b\x7d\x20\x7d\x0d\x0a\x66\x75\x6e\x63\x20\x28\x61\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x29\x20\x49\x73\x44\x69\x72\x28\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x64\x69\x72\x20\x7d\x0d\x0a\x66\x75\x6e\x63\x20\x28\x61\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x29\x20\x53\x79\x73\x28\x29\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x20\x7d\x0d\x0a\x66\x75\x6e\x63\x20\x28\x61\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x29\x20\x54\x79\x70\x65\x28\x29\x20\x66\x73\x2e\x46\x69\x6c\x65\x4d\x6f\x64\x65\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x4d\x6f\x64\x65\x28\x29\x2e\x54\x79\x70\x65\x28\x29\x20\x7d\x0d\x0a\x66\x75\x6e\x63\x20\x28\x61\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x29\x20\x49\x6e\x66\x6f\x28\x29\x20\x28\x66\x73\x2e\x46\x69\x6c\x65\x49\x6e\x66\x6f\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x61\x2c\x20\x6e\x69\x6c\x20\x7d\x0d\x0a\x0d\x0a\x66\x75\x6e\x63\x20\x28\x61\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x29\x20\x4d\x6f\x64\x65\x28\x29\x20\x66\x73\x2e\x46\x69\x6c\x65\x4d\x6f\x64\x65\x20\x7b\x0d\x0a\x20\x20\x69\x66\x20\x61\x2e\x64\x69\x72\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x73\x2e\x4d\x6f\x64\x65\x44\x69\x72\x20\x7c\x20\x30\x35\x35\x35\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x30\x34\x34\x34\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x73\x20\x66\x73\x2e\x46\x69\x6c\x65\x20\x61\x6e\x64\x20\x68\x74\x74\x70\x2e\x46\x69\x6c\x65\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x6f\x66\x20\x61\x20\x66\x69\x6c\x65\x2e\x0d\x0a\x74\x79\x70\x65\x20\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x20\x73\x74\x72\x75\x63\x74\x20\x7b\x0d\x0a\x20\x20\x2a\x62\x79\x74\x65\x73\x2e\x52\x65\x61\x64\x65\x72\x0d\x0a\x20\x20\x69\x6e\x66\x6f\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x66\x75\x6e\x63\x20\x28\x66\x20\x2a\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x29\x20\x53\x74\x61\x74\x28\x29\x20\x28\x66\x73\x2e\x46\x69\x6c\x65\x49\x6e\x66\x6f\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x2e\x69\x6e\x66\x6f\x2c\x20\x6e\x69\x6c\x20\x7d\x0d\x0a\x66\x75\x6e\x63\x20\x28\x66\x20\x2a\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x29\x20\x43\x6c\x6f\x73\x65\x28\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x20\x7d\x0d\x0a\x0d\x0a\x66\x75\x6e\x63\x20\x28\x66\x20\x2a\x61\x73\x73\x65\x74\x46\x69\x6c\x65\x29\x20\x52\x65\x61\x64\x64\x69\x72\x28\x63\x6f\x75\x6e\x74\x20\x69\x6e\x74\x29\x20\x28\x5b\x5d\x66\x73\x2e\x46\x69\x6c\x65\x49\x6e\x66\x6f\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x26\x66\x73\x2e\x50\x61\x74\x68\x45\x72\x72\x6f\x72\x7b\x4f\x70\x3a\x20\x22\x72\x65\x61\x64\x64\x69\x72\x22\x2c\x20\x50\x61\x74\x68\x3a\x20\x66\x2e\x69\x6e\x66\x6f\x2e\x6e\x61\x6d\x65\x2c\x20\x45\x72\x72\x3a\x20\x66\x73\x2e\x45\x72\x72\x49\x6e\x76\x61\x6c\x69\x64\x7d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x61\x73\x73\x65\x74\x44\x69\x72\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x73\x20\x66\x73\x2e\x52\x65\x61\x64\x44\x69\x72\x46\x69\x6c\x65\x20\x61\x6e\x64\x20\x68\x74\x74\x70\x2e\x46\x69\x6c\x65\x20\x66\x6f\x72\x20\x61\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x2e\x0d\x0a\x74\x79\x70\x65\x20\x61\x73\x73\x65\x74\x44\x69\x72\x20\x73\x74\x72\x75\x63\x74\x20\x7b\x0d\x0a\x20\x20\x69\x6e\x66\x6f\x20\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x0d\x0a\x20\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x5b\x5d\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x0d\x0a\x20\x20\x6f\x66\x66\x73\x65\x74\x20\x69\x6e\x74\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x66\x75\x6e\x63\x20\x28\x64\x20\x2a\x61\x73\x73\x65\x74\x44\x69\x72\x29\x20\x53\x74\x61\x74\x28\x29\x20\x28\x66\x73\x2e\x46\x69\x6c\x65\x49\x6e\x66\x6f\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x69\x6e\x66\x6f\x2c\x20\x6e\x69\x6c\x20\x7d\x0d\x0a\x66\x75\x6e\x63\x20\x28\x64\x20\x2a\x61\x73\x73\x65\x74\x44\x69\x72\x29\x20\x43\x6c\x6f\x73\x65\x28\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x20\x7d\x0d\x0a\x0d\x0a\x66\x75\x6e\x63\x20\x28\x64\x20\x2a\x61\x73\x73\x65\x74\x44\x69\x72\x29\x20\x52\x65\x61\x64\x28\x5b\x5d\x62\x79\x74\x65\x29\x20\x28\x69\x6e\x74\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x30\x2c\x20\x26\x66\x73\x2e\x50\x61\x74\x68\x45\x72\x72\x6f\x72\x7b\x4f\x70\x3a\x20\x22\x72\x65\x61\x64\x22\x2c\x20\x50\x61\x74\x68\x3a\x20\x64\x2e\x69\x6e\x66\x6f\x2e\x6e\x61\x6d\x65\x2c\x20\x45\x72\x72\x3a\x20\x66\x73\x2e\x45\x72\x72\x49\x6e\x76\x61\x6c\x69\x64\x7d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x66\x75\x6e\x63\x20\x28\x64\x20\x2a\x61\x73\x73\x65\x74\x44\x69\x72\x29\x20\x53\x65\x65\x6b\x28\x69\x6e\x74\x36\x34\x2c\x20\x69\x6e\x74\x29\x20\x28\x69\x6e\x74\x36\x34\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x30\x2c\x20\x26\x66\x73\x2e\x50\x61\x74\x68\x45\x72\x72\x6f\x72\x7b\x4f\x70\x3a\x20\x22\x73\x65\x65\x6b\x22\x2c\x20\x50\x61\x74\x68\x3a\x20\x64\x2e\x69\x6e\x66\x6f\x2e\x6e\x61\x6d\x65\x2c\x20\x45\x72\x72\x3a\x20\x66\x73\x2e\x45\x72\x72\x49\x6e\x76\x61\x6c\x69\x64\x7d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x61\x64\x44\x69\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x6e\x65\x78\x74\x20\x63\x6f\x75\x6e\x74\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x2c\x20\x6f\x72\x20\x61\x6c\x6c\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x0d\x0a\x2f\x2f\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x69\x66\x20\x63\x6f\x75\x6e\x74\x20\x69\x73\x20\x6e\x6f\x74\x20\x61\x62\x6f\x76\x65\x20\x7a\x65\x72\x6f\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x64\x20\x2a\x61\x73\x73\x65\x74\x44\x69\x72\x29\x20\x52\x65\x61\x64\x44\x69\x72\x28\x63\x6f\x75\x6e\x74\x20\x69\x6e\x74\x29\x20\x28\x5b\x5d\x66\x73\x2e\x44\x69\x72\x45\x6e\x74\x72\x79\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x65\x6e\x74\x72\x69\x65\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x64\x2e\x6e\x65\x78\x74\x28\x63\x6f\x75\x6e\x74\x29\x0d\x0a\x0d\x0a\x20\x20\x64\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x20\x3a\x3d\x20\x6d\x61\x6b\x65\x28\x5b\x5d\x66\x73\x2e\x44\x69\x72\x45\x6e\x74\x72\x79\x2c\x20\x6c\x65\x6e\x28\x65\x6e\x74\x72\x69\x65\x73\x29\x29\x0d\x0a\x20\x20\x66\x6f\x72\x20\x69\x6e\x64\x65\x78\x2c\x20\x65\x6e\x74\x72\x79\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x7b\x0d\x0a\x20\x20\x20\x20\x64\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x5b\x69\x6e\x64\x65\x78\x5d\x20\x3d\x20\x65\x6e\x74\x72\x79\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x64\x69\x72\x45\x6e\x74\x72\x69\x65\x73\x2c\x20\x65\x72\x72\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x61\x64\x64\x69\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x6e\x65\x78\x74\x20\x63\x6f\x75\x6e\x74\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x2c\x20\x6f\x72\x20\x61\x6c\x6c\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x0d\x0a\x2f\x2f\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x69\x66\x20\x63\x6f\x75\x6e\x74\x20\x69\x73\x20\x6e\x6f\x74\x20\x61\x62\x6f\x76\x65\x20\x7a\x65\x72\x6f\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x64\x20\x2a\x61\x73\x73\x65\x74\x44\x69\x72\x29\x20\x52\x65\x61\x64\x64\x69\x72\x28\x63\x6f\x75\x6e\x74\x20\x69\x6e\x74\x29\x20\x28\x5b\x5d\x66\x73\x2e\x46\x69\x6c\x65\x49\x6e\x66\x6f\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x65\x6e\x74\x72\x69\x65\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x64\x2e\x6e\x65\x78\x74\x28\x63\x6f\x75\x6e\x74\x29\x0d\x0a\x0d\x0a\x20\x20\x69\x6e\x66\x6f\x73\x20\x3a\x3d\x20\x6d\x61\x6b\x65\x28\x5b\x5d\x66\x73\x2e\x46\x69\x6c\x65\x49\x6e\x66\x6f\x2c\x20\x6c\x65\x6e\x28\x65\x6e\x74\x72\x69\x65\x73\x29\x29\x0d\x0a\x20\x20\x66\x6f\x72\x20\x69\x6e\x64\x65\x78\x2c\x20\x65\x6e\x74\x72\x79\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x7b\x0d\x0a\x20\x20\x20\x20\x69\x6e\x66\x6f\x73\x5b\x69\x6e\x64\x65\x78\x5d\x20\x3d\x20\x65\x6e\x74\x72\x79\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x6e\x66\x6f\x73\x2c\x20\x65\x72\x72\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x6e\x65\x78\x74\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x74\x68\x65\x20\x6e\x65\x78\x74\x20\x63\x6f\x75\x6e\x74\x20\x65\x6e\x74\x72\x69\x65\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x2c\x20\x72\x65\x74\x75\x72\x6e\x69\x6e\x67\x20\x69\x6f\x2e\x45\x4f\x46\x20\x77\x68\x65\x6e\x0d\x0a\x2f\x2f\x20\x6e\x6f\x6e\x65\x20\x72\x65\x6d\x61\x69\x6e\x20\x66\x6f\x72\x20\x61\x20\x63\x6f\x75\x6e\x74\x20\x61\x62\x6f\x76\x65\x20\x7a\x65\x72\x6f\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x64\x20\x2a\x61\x73\x73\x65\x74\x44\x69\x72\x29\x20\x6e\x65\x78\x74\x28\x63\x6f\x75\x6e\x74\x20\x69\x6e\x74\x29\x20\x28\x5b\x5d\x61\x73\x73\x65\x74\x49\x6e\x66\x6f\x2c\x20\x65\x72\x72\x6f\x72\x29\x20\x7b\x0d\x0a\x20\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x20\x3a\x3d\x20\x64\x2e\x65\x6e\x74\x72\x69\x65\x73\x5b\x64\x2e\x6f\x66\x66\x73\x65\x74\x3a\x5d\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x63\x6f\x75\x6e\x74\x20\x3e\x20\x30\x20\x7b\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x6c\x65\x6e\x28\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x29\x20\x3d\x3d\x20\x30\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x2c\x20\x69\x6f\x2e\x45\x4f\x46\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x63\x6f\x75\x6e\x74\x20\x3c\x20\x6c\x65\x6e\x28\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x20\x3d\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x5b\x3a\x63\x6f\x75\x6e\x74\x5d\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x64\x2e\x6f\x66\x66\x73\x65\x74\x20\x2b\x3d\x20\x6c\x65\x6e\x28\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x29\x0d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x2c\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a")
 
 	files["scaffolds/pack-bundle.gen"] = []byte("\x2f\x2f\x2b\x62\x75\x69\x6c\x64\x20\x69\x67\x6e\x6f\x72\x65\x0d\x0a\x0d\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x6d\x61\x69\x6e\x0d\x0a\x0d\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0d\x0a\x09\x22\x66\x6d\x74\x22\x0d\x0a\x09\x22\x6f\x73\x22\x0d\x0a\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x75\x2d\x69\x6f\x2f\x67\x75\x2f\x61\x73\x73\x65\x74\x73\x22\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x75\x2d\x69\x6f\x2f\x67\x75\x2f\x61\x73\x73\x65\x74\x73\x2f\x70\x61\x63\x6b\x65\x72\x73\x22\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x69\x6e\x66\x6c\x75\x78\x36\x2f\x6d\x6f\x7a\x2f\x67\x65\x6e\x22\x0d\x0a\x29\x0d\x0a\x0d\x0a\x66\x75\x6e\x63\x20\x6d\x61\x69\x6e\x28\x29\x7b\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x20\x3a\x3d\x20\x61\x73\x73\x65\x74\x73\x2e\x4e\x65\x77\x28\x70\x61\x63\x6b\x65\x72\x73\x2e\x52\x61\x77\x50\x61\x63\x6b\x65\x72\x7b\x7d\x29\x0d\x0a\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x52\x65\x67\x69\x73\x74\x65\x72\x28\x22\x2e\x6a\x73\x22\x2c\x20\x70\x61\x63\x6b\x65\x72\x73\x2e\x4a\x53\x50\x61\x63\x6b\x65\x72\x7b\x7d\x29\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x52\x65\x67\x69\x73\x74\x65\x72\x28\x22\x2e\x63\x73\x73\x22\x2c\x20\x70\x61\x63\x6b\x65\x72\x73\x2e\x43\x53\x53\x50\x61\x63\x6b\x65\x72\x7b\x43\x6c\x65\x61\x6e\x43\x53\x53\x3a\x20\x74\x72\x75\x65\x7d\x29\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x52\x65\x67\x69\x73\x74\x65\x72\x28\x22\x2e\x73\x74\x61\x74\x69\x63\x2e\x68\x74\x6d\x6c\x22\x2c\x20\x70\x61\x63\x6b\x65\x72\x73\x2e\x53\x74\x61\x74\x69\x63\x4d\x61\x72\x6b\x75\x70\x50\x61\x63\x6b\x65\x72\x7b\x0d\x0a\x09\x09\x50\x61\x63\x6b\x61\x67\x65\x4e\x61\x6d\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x54\x61\x72\x67\x65\x74\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x2c\x0d\x0a\x09\x09\x44\x65\x73\x74\x69\x6e\x61\x74\x69\x6f\x6e\x46\x69\x6c\x65\x3a\x20\x22\x7b\x7b\x2e\x54\x61\x72\x67\x65\x74\x44\x69\x72\x7d\x7d\x2f\x7b\x7b\x6c\x6f\x77\x65\x72\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x5f\x73\x74\x61\x74\x69\x63\x5f\x62\x75\x6e\x64\x6c\x65\x2e\x67\x6f\x22\x2c\x0d\x0a\x09\x7d\x29\x0d\x0a\x0d\x0a\x09\x7b\x7b\x20\x69\x66\x20\x6e\x6f\x74\x65\x71\x75\x61\x6c\x20\x2e\x4c\x65\x73\x73\x46\x69\x6c\x65\x20\x22\x22\x20\x7d\x7d\x0d\x0a\x20\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x52\x65\x67\x69\x73\x74\x65\x72\x28\x22\x2e\x6c\x65\x73\x73\x22\x2c\x20\x70\x61\x63\x6b\x65\x72\x73\x2e\x4c\x65\x73\x73\x50\x61\x63\x6b\x65\x72\x7b\x4d\x61\x69\x6e\x46\x69\x6c\x65\x3a\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4c\x65\x73\x73\x46\x69\x6c\x65\x7d\x7d\x20\x7d\x29\x0d\x0a\x09\x7b\x7b\x20\x65\x6e\x64\x7d\x7d\x0d\x0a\x0d\x0a\x20\x20\x77\x72\x69\x74\x65\x72\x2c\x20\x73\x74\x61\x74\x69\x63\x73\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x61\x73\x70\x61\x63\x6b\x65\x72\x2e\x43\x6f\x6d\x70\x69\x6c\x65\x28\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x54\x61\x72\x67\x65\x74\x44\x69\x72\x7d\x7d\x2c\x20\x66\x61\x6c\x73\x65\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x09\x70\x69\x70\x65\x47\x65\x6e\x20\x3a\x3d\x20\x67\x65\x6e\x2e\x42\x6c\x6f\x63\x6b\x28\x0d\x0a\x09\x09\x67\x65\x6e\x2e\x50\x61\x63\x6b\x61\x67\x65\x28\x0d\x0a\x09\x09\x09\x67\x65\x6e\x2e\x4e\x61\x6d\x65\x28\x22\x7b\x7b\x2e\x54\x61\x72\x67\x65\x74\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x22\x29\x2c\x0d\x0a\x20\x20\x20\x20\x20\x20\x77\x72\x69\x74\x65\x72\x2c\x0d\x0a\x20\x20\x20\x20\x29\x2c\x0d\x0a\x20\x20\x29\x0d\x0a\x0d\x0a\x09\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x47\x65\x74\x77\x64\x28\x29\x0d\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x09\x7d\x0d\x0a\x0d\x0a\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x72\x69\x74\x65\x54\x6f\x46\x69\x6c\x65\x28\x70\x69\x70\x65\x47\x65\x6e\x2c\x66\x6d\x74\x2e\x53\x70\x72\x69\x6e\x74\x66\x28\x22\x25\x73\x5f\x62\x75\x6e\x64\x6c\x65\x2e\x67\x6f\x22\x2c\x20\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x29\x2c\x7b\x7b\x20\x71\x75\x6f\x74\x65\x20\x2e\x54\x61\x72\x67\x65\x74\x44\x69\x72\x7d\x7d\x2c\x20\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x09\x7d\x0d\x0a\x0d\x0a\x09\x66\x6f\x72\x20\x5f\x2c\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x73\x74\x61\x74\x69\x63\x73\x20\x7b\x0d\x0a\x09\x09\x66\x6f\x72\x20\x5f\x2c\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x73\x20\x7b\x0d\x0a\x09\x09\x09\x69\x66\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x2e\x53\x74\x61\x74\x69\x63\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x09\x09\x63\x6f\x6e\x74\x69\x6e\x75\x65\x0d\x0a\x09\x09\x09\x7d\x0d\x0a\x0d\x0a\x09\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x72\x69\x74\x65\x54\x6f\x46\x69\x6c\x65\x28\x64\x69\x72\x65\x63\x74\x69\x76\x65\x2e\x57\x72\x69\x74\x65\x72\x2c\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x2e\x53\x74\x61\x74\x69\x63\x2e\x46\x69\x6c\x65\x4e\x61\x6d\x65\x2c\x20\x64\x69\x72\x65\x63\x74\x69\x76\x65\x2e\x53\x74\x61\x74\x69\x63\x2e\x44\x69\x72\x4e\x61\x6d\x65\x2c\x20\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x09\x09\x70\x61\x6e\x69\x63\x28\x65\x72\x72\x29\x0d\x0a\x09\x09\x09\x7d\x0d\x0a\x09\x09\x7d\x0d\x0a\x09\x7d\x0d\x0a\x0d\x0a\x20\x20\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x6c\x6e\x28\x22\x42\x75\x6e\x64\x6c\x69\x6e\x67\x20\x63\x6f\x6d\x70\x6c\x65\x74\x65\x64\x20\x66\x6f\x72\x20\x27\x7b\x7b\x2e\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x27\x22\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x77\x72\x69\x74\x65\x54\x6f\x46\x69\x6c\x65\x20\x77\x72\x69\x74\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x63\x6f\x6e\x74\x65\x6e\x74\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x57\x72\x69\x74\x65\x72\x54\x6f\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x74\x6f\x20\x74\x68\x65\x20\x66\x69\x6c\x65\x20\x6f\x66\x0d\x0a\x2f\x2f\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x66\x69\x6c\x65\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x77\x72\x69\x74\x65\x54\x6f\x46\x69\x6c\x65\x28\x77\x20\x69\x6f\x2e\x57\x72\x69\x74\x65\x72\x54\x6f\x2c\x20\x66\x69\x6c\x65\x4e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x64\x69\x72\x4e\x61\x6d\x65\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x20\x73\x74\x72\x69\x6e\x67\x29\x20\x65\x72\x72\x6f\x72\x20\x7b\x0d\x0a\x09\x63\x6f\x44\x69\x72\x20\x3a\x3d\x20\x66\x69\x6c\x65\x70\x61\x74\x68\x2e\x4a\x6f\x69\x6e\x28\x63\x75\x72\x72\x65\x6e\x74\x44\x69\x72\x2c\x20\x64\x69\x72\x4e\x61\x6d\x65\x29\x0d\x0a\x0d\x0a\x09\x69\x66\x20\x64\x69\x72\x4e\x61\x6d\x65\x20\x21\x3d\x20\x22\x22\x20\x7b\x0d\x0a\x09\x09\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x53\x74\x61\x74\x28\x63\x6f\x44\x69\x72\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x09\x09\x69\x66\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x4d\x6b\x64\x69\x72\x41\x6c\x6c\x28\x63\x6f\x44\x69\x72\x2c\x20\x30\x37\x30\x30\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x65\x72\x72\x20\x21\x3d\x20\x6f\x73\x2e\x45\x72\x72\x45\x78\x69\x73\x74\x20\x7b\x0d\x0a\x09\x09\x09\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0d\x0a\x09\x09\x09\x09\x7d\x0d\x0a\x0d\x0a\x09\x09\x09\x09\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x66\x28\x22\x2d\x20\x43\x72\x65\x61\x74\x65\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x3a\x20\x25\x71\x5c\x6e\x22\x2c\x20\x63\x6f\x44\x69\x72\x29\x0d\x0a\x09\x09\x7d\x0d\x0a\x09\x7d\x0d\x0a\x0d\x0a\x09\x63\x6f\x46\x69\x6c\x65\x20\x3a\x3d\x20\x66\x69\x6c\x65\x70\x61\x74\x68\x2e\x4a\x6f\x69\x6e\x28\x63\x6f\x44\x69\x72\x2c\x20\x66\x69\x6c\x65\x4e\x61\x6d\x65\x29\x0d\x0a\x09\x66\x69\x6c\x65\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x6f\x73\x2e\x43\x72\x65\x61\x74\x65\x28\x63\x6f\x46\x69\x6c\x65\x29\x0d\x0a\x20\x20\x69\x66\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x64\x65\x66\x65\x72\x20\x66\x69\x6c\x65\x2e\x43\x6c\x6f\x73\x65\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x69\x66\x20\x5f\x2c\x20\x65\x72\x72\x20\x3a\x3d\x20\x77\x2e\x57\x72\x69\x74\x65\x54\x6f\x28\x66\x69\x6c\x65\x29\x3b\x20\x65\x72\x72\x20\x21\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x09\x09\x72\x65\x74\x75\x72\x6e\x20\x65\x72\x72\x0d\x0a\x20\x20\x7d\x0d\x0a\x0d\x0a\x09\x66\x6d\x74\x2e\x50\x72\x69\x6e\x74\x66\x28\x22\x2d\x20\x43\x72\x65\x61\x74\x65\x64\x20\x64\x69\x72\x65\x63\x74\x6f\x72\x79\x20\x66\x69\x6c\x65\x3a\x20\x25\x71\x5c\x6e\x22\x2c\x20\x66\x69\x6c\x65\x70\x61\x74\x68\x2e\x4a\x6f\x69\x6e\x28\x64\x69\x72\x4e\x61\x6d\x65\x2c\x20\x66\x69\x6c\x65\x4e\x61\x6d\x65\x29\x29\x0d\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x6e\x69\x6c\x0d\x0a\x7d\x0d\x0a")
 
@@ -2,11 +2,13 @@
 package gu
 
 import (
+	"context"
 	"fmt"
 	"html/template"
 	"sync"
 	"sync/atomic"
 
+	"github.com/gu-io/gu/notifications"
 	"github.com/gu-io/gu/router"
 	"github.com/gu-io/gu/trees"
 )
@@ -43,6 +45,30 @@ type Location interface {
 	Navigate(router.PushDirectiveEvent)
 }
 
+// History defines a Location which keeps the history of it's routes, moving
+// back and forward through them like browsers.
+type History interface {
+	Location
+
+	// Go moves the current route by delta, activating it on the app. It returns
+	// false if there is no route at the position.
+	Go(delta int) bool
+
+	// Back moves to the previous route.
+	Back() bool
+
+	// Forward moves to the next route.
+	Forward() bool
+
+	// Scroll returns the scroll position to restore for the current route, which
+	// is the top of the page for routes not visited before.
+	Scroll() router.ScrollPosition
+
+	// SaveScroll records the scroll position of the page on the current route,
+	// before it is left.
+	SaveScroll(router.ScrollPosition)
+}
+
 // NoopLocation defines a basic struct which implements the Location interface
 // and is used to stand in for a app when not provided one.
 type NoopLocation struct {
@@ -61,8 +87,9 @@ func NewNoopLocation(app *NApp) *NoopLocation {
 // NOOPLocation which returns that always.
 func (n *NoopLocation) Navigate(pe router.PushDirectiveEvent) {
 	if newLocation, err := router.NewPushEvent(pe.To, true); err == nil {
-		n.app.ActivateRoute(newLocation)
+		// Set the location first, so redirects of guards replace it.
 		n.current = &newLocation
+		n.app.ActivateRoute(newLocation)
 	}
 }
 
@@ -78,6 +105,86 @@ func (n *NoopLocation) Location() router.PushEvent {
 	return *n.current
 }
 
+// MemoryLocation defines a struct which implements the History interface, keeping
+// the history of the routes in memory, for rendering apps on the server and in
+// tests with the history semantics of the browser.
+type MemoryLocation struct {
+	app     *NApp
+	mode    router.Mode
+	history *router.History
+}
+
+// NewMemoryLocation returns a new instance of a MemoryLocation, which resolves
+// routes with the mode.
+func NewMemoryLocation(app *NApp, mode router.Mode) *MemoryLocation {
+	root, _ := mode.Event("/")
+
+	return &MemoryLocation{
+		app:     app,
+		mode:    mode,
+		history: router.NewHistory(root),
+	}
+}
+
+// Navigate pushes the route of the directive into the history, or replaces the
+// current route with it, and activates it on the app.
+func (m *MemoryLocation) Navigate(pd router.PushDirectiveEvent) {
+	event, err := m.mode.Event(pd.To)
+	if err != nil {
+		return
+	}
+
+	// Set the location first, so redirects of guards replace it.
+	m.history.Navigate(event, pd)
+	m.app.ActivateRoute(event)
+}
+
+// Location returns the current route.
+func (m *MemoryLocation) Location() router.PushEvent {
+	return m.history.Current().Event
+}
+
+// Go moves the current route by delta, activating it on the app.
+func (m *MemoryLocation) Go(delta int) bool {
+	entry, ok := m.history.Go(delta)
+	if !ok {
+		return false
+	}
+
+	m.app.ActivateRoute(entry.Event)
+	return true
+}
+
+// Back moves to the previous route.
+func (m *MemoryLocation) Back() bool {
+	return m.Go(-1)
+}
+
+// Forward moves to the next route.
+func (m *MemoryLocation) Forward() bool {
+	return m.Go(1)
+}
+
+// Scroll returns the scroll position to restore for the current route.
+func (m *MemoryLocation) Scroll() router.ScrollPosition {
+	return m.history.Current().Scroll
+}
+
+// SaveScroll records the scroll position of the page on the current route.
+func (m *MemoryLocation) SaveScroll(position router.ScrollPosition) {
+	m.history.SaveScroll(position)
+}
+
+// URL returns the url of the current route.
+func (m *MemoryLocation) URL() string {
+	return m.mode.URL(m.Location())
+}
+
+// Entries returns the routes of the history.
+func (m *MemoryLocation) Entries() []router.HistoryEntry {
+	return m.history.Entries()
+}
+
 //==============================================================================
 
 // Identity defines an interface which expoese the identity of a giving object.
@@ -87,19 +194,56 @@ type Identity interface {
 
 // AppUpdate defines a struct which is used to notify the need to update a
 // App.
-//@notification:event
+// @notification:event
 type AppUpdate struct {
 	App *NApp
 }
 
 // ViewUpdate defines a struct which is used to notify the need to update a
 // App and a given view.
-//@notification:event
+// @notification:event
 type ViewUpdate struct {
 	App  *NApp
 	View *NView
 }
 
+// ViewLoadFailed defines a struct which is used to notify that the loaders of a
+// view failed for a route, leaving the view unmounted.
+// @notification:event
+type ViewLoadFailed struct {
+	App   *NApp
+	View  *NView
+	Route router.PushEvent
+	Err   error
+}
+
+//================================================================================
+
+// Guard defines a function which decides if a route activates a view or
+// component, returning false to block it. A non-empty redirect navigates the
+// app to the redirect route instead of activating the route.
+type Guard func(router.PushEvent) (allow bool, redirect string)
+
+// Loader defines a function which loads the data of a view for a route before
+// it is rendered, making it's requests through the router with the context,
+// which is cancelled when another route is activated. A returned error fails
+// the activation of the view.
+type Loader func(ctx context.Context, rt *router.Router, route router.PushEvent) error
+
+// ViewState defines the state of the loaders of a view.
+type ViewState int
+
+const (
+	// ViewReady defines that the loaders of the view succeeded.
+	ViewReady ViewState = iota
+
+	// ViewPending defines that the loaders of the view are running.
+	ViewPending
+
+	// ViewFailed defines that a loader of the view failed, with the error returned by NView.State.
+	ViewFailed
+)
+
 //================================================================================
 
 // Services defines a struct which exposes certain fields to be accessible to
@@ -111,8 +255,19 @@ type Services struct {
 	Rendered  Subscriptions
 	Updated   Subscriptions
 	Unmounted Subscriptions
-	Router    *router.Router
 	ViewRoute router.Resolver
+
+	// Router contains the router of the app, whose requests are cancelled when
+	// the view of the component is unmounted.
+	Router *router.Router
+
+	// Routes contains the named routes used to build the urls components
+	// navigate to.
+	Routes *router.Routes
+
+	// Notifications contains the notifications of the app, which components
+	// can use to dispatch and listen for events scoped to their app.
+	Notifications *notifications.Notifications
 }
 
 //================================================================================
@@ -155,9 +310,10 @@ type Reactive interface {
 // RenderCommand defines a struct to hold a giving command for the rendering
 // of a App or View using the JSON format.
 type RenderCommand struct {
-	Command string   `json:"Command"`
-	App     AppJSON  `json:"App,omitempty"`
-	View    ViewJSON `json:"View,omitempty"`
+	Command string        `json:"Command"`
+	App     AppJSON       `json:"App,omitempty"`
+	View    ViewJSON      `json:"View,omitempty"`
+	Patch   ViewPatchJSON `json:"Patch,omitempty"`
 }
 
 // AppRenderCommand returns a new RenderCommand for rendering a app.
@@ -168,6 +324,19 @@ func AppRenderCommand(app *NApp, route interface{}) RenderCommand {
 	}
 }
 
+// AppHydrateCommand returns a new RenderCommand for hydrating a app, where the
+// page rendered by the app (see NApp.Render) is adopted by the client instead of
+// being replaced, having only differences patched and events attached. The app
+// must be the same instance which rendered the page, as nodes are matched by
+// their uid, where the views are sent with the markup they last rendered (see
+// NView.HydrateJSON).
+func AppHydrateCommand(app *NApp, route interface{}) RenderCommand {
+	return RenderCommand{
+		Command: "HydrateApp",
+		App:     app.HydrateJSON(route),
+	}
+}
+
 // ViewRenderCommand returns a new RenderCommand for rendering a view.
 func ViewRenderCommand(view *NView) RenderCommand {
 	return RenderCommand{
@@ -176,6 +345,20 @@ func ViewRenderCommand(view *NView) RenderCommand {
 	}
 }
 
+// ViewPatchCommand returns a new RenderCommand for patching a view with the
+// changes since it was last rendered. If the view has not being rendered then
+// a command for rendering the view is returned.
+func ViewPatchCommand(view *NView) RenderCommand {
+	if view.live == nil {
+		return ViewRenderCommand(view)
+	}
+
+	return RenderCommand{
+		Command: "PatchView",
+		Patch:   view.PatchJSON(),
+	}
+}
+
 //==============================================================================
 
 // NewReactive returns an instance of a Reactive struct.
@@ -2,6 +2,7 @@ package notifications
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/gu-io/gu/common"
 )
@@ -9,24 +10,24 @@ import (
 // dispatch provides a default dispatcher for listening to events.
 var dispatch = New()
 
-// Unsubscribe adds a new listener to the dispatcher.
+// Default returns the default dispatcher used by the package level functions.
+func Default() *Notifications {
+	return dispatch
+}
+
+// Unsubscribe removes a listener from the default dispatcher.
 func Unsubscribe(dist EventDistributor) {
-	dispatch.UnNotify(dist)
+	dispatch.Unsubscribe(dist)
 }
 
-// Subscribe adds a new listener to the dispatcher.
+// Subscribe adds a new listener to the default dispatcher.
 func Subscribe(dist EventDistributor) {
-	dispatch.Notify(dist)
+	dispatch.Subscribe(dist)
 }
 
-// SubscribeWithRemover adds a new listener to the dispatcher and returns a common.Remover .
+// SubscribeWithRemover adds a new listener to the default dispatcher and returns a common.Remover .
 func SubscribeWithRemover(dist EventDistributor) common.Remover {
-	dispatch.Notify(dist)
-
-	return listenerRemover{
-		root:    dispatch,
-		handler: dist,
-	}
+	return dispatch.SubscribeWithRemover(dist)
 }
 
 // ListenerRemover defines a struct which implements the common.Remover interface.
@@ -37,12 +38,12 @@ type listenerRemover struct {
 }
 
 // Adds adds a callback to be called when Remove is called.
-func (l listenerRemover) Add(fn func()) {
+func (l *listenerRemover) Add(fn func()) {
 	l.fn = append(l.fn, fn)
 }
 
 // Remove implements the common.Remover.
-func (l listenerRemover) Remove() {
+func (l *listenerRemover) Remove() {
 	l.root.UnNotify(l.handler)
 
 	for _, fx := range l.fn {
@@ -52,9 +53,9 @@ func (l listenerRemover) Remove() {
 	l.fn = nil
 }
 
-// Dispatch emits a event into the dispatch callback listeners.
+// Dispatch emits a event into the default dispatcher's listeners.
 func Dispatch(q interface{}) {
-	dispatch.Handle(q)
+	dispatch.Dispatch(q)
 }
 
 // EventDistributor defines a interface that exposes a single method which
@@ -63,53 +64,273 @@ type EventDistributor interface {
 	Handle(interface{})
 }
 
+// Delivery defines the way a Notifications delivers dispatched events to it's
+// listeners.
+type Delivery int
+
+const (
+	// Immediate delivers a event to all listeners before Dispatch returns. A
+	// event dispatched by a listener during delivery is delivered immediately,
+	// before the remaining listeners receive the current event.
+	Immediate Delivery = iota
+
+	// Queued delivers events in the order they are dispatched, with each event
+	// delivered to all listeners before the next. Dispatch delivers the event
+	// and all events queued till the queue is empty, except when a event is
+	// already being delivered, where the event is queued and Dispatch returns
+	// immediately, leaving it to be delivered after the current event.
+	Queued
+
+	// Async delivers events in the order they are dispatched on a separate
+	// goroutine, with Dispatch returning immediately. Close must be called to
+	// stop the goroutine once the Notifications is no longer used.
+	Async
+)
+
+// subscriber defines a listener registered with a Notifications.
+type subscriber struct {
+	dist    EventDistributor
+	removed int32
+}
+
 // Notifications defines a central delivery pipe where all types of event notifications
 // will pass through to be delivered to all EventDistributor listening.
+//
+// Events are delivered to a snapshot of the listeners taken when delivery of the
+// event starts, so listeners can dispatch events, subscribe and unsubscribe
+// during delivery. Listeners subscribed during delivery receive only later
+// events, while listeners unsubscribed during delivery are not delivered the
+// current event if they have not received it yet.
 type Notifications struct {
 	ml       sync.Mutex
-	sources  []EventDistributor
-	register map[EventDistributor]int
+	sources  []*subscriber
+	register map[EventDistributor]*subscriber
+
+	delivery   Delivery
+	ql         sync.Mutex
+	qc         *sync.Cond
+	queue      []interface{}
+	delivering bool
+	started    bool
+	closed     bool
 }
 
-// New returns a new instance of a Notification primitive.
+// New returns a new instance of a Notification primitive, which delivers events
+// Immediate.
 func New() *Notifications {
+	return NewWithDelivery(Immediate)
+}
+
+// NewWithDelivery returns a new instance of a Notification primitive, which
+// delivers events with the giving Delivery.
+func NewWithDelivery(delivery Delivery) *Notifications {
 	var nl Notifications
-	nl.register = make(map[EventDistributor]int, 0)
+	nl.delivery = delivery
+	nl.register = make(map[EventDistributor]*subscriber, 0)
+	nl.qc = sync.NewCond(&nl.ql)
 	return &nl
 }
 
+// Unsubscribe removes the giving listener from the dispatcher.
+func (n *Notifications) Unsubscribe(dist EventDistributor) {
+	n.UnNotify(dist)
+}
+
+// Subscribe adds a new listener to the dispatcher.
+func (n *Notifications) Subscribe(dist EventDistributor) {
+	n.Notify(dist)
+}
+
+// SubscribeWithRemover adds a new listener to the dispatcher and returns a
+// common.Remover which removes it.
+func (n *Notifications) SubscribeWithRemover(dist EventDistributor) common.Remover {
+	n.Notify(dist)
+
+	return &listenerRemover{
+		root:    n,
+		handler: dist,
+	}
+}
+
+// Dispatch emits a event into the dispatcher's listeners.
+func (n *Notifications) Dispatch(q interface{}) {
+	n.Handle(q)
+}
+
 // UnNotify removes the giving distributor from the notification system.
 func (n *Notifications) UnNotify(source EventDistributor) {
 	n.do(func() {
-		index, ok := n.register[source]
+		sub, ok := n.register[source]
 		if !ok {
 			return
 		}
 
-		n.sources[index] = nil
-		n.sources = append(n.sources[:index], n.sources[index+1:]...)
+		delete(n.register, source)
+		atomic.StoreInt32(&sub.removed, 1)
+
+		// The sources are copied on change, as deliveries in progress hold
+		// snapshots of it.
+		sources := make([]*subscriber, 0, len(n.sources)-1)
+		for _, item := range n.sources {
+			if item != sub {
+				sources = append(sources, item)
+			}
+		}
+
+		n.sources = sources
 	})
 }
 
-// Notify adds a giving EventDistributor into the notifications list.
+// Notify adds a giving EventDistributor into the notifications list, it does
+// nothing if the distributor is already added.
 func (n *Notifications) Notify(source EventDistributor) {
 	n.do(func() {
-		n.register[source] = len(n.sources)
-		n.sources = append(n.sources, source)
+		if _, ok := n.register[source]; ok {
+			return
+		}
+
+		sub := &subscriber{dist: source}
+		n.register[source] = sub
+
+		sources := make([]*subscriber, len(n.sources), len(n.sources)+1)
+		copy(sources, n.sources)
+		n.sources = append(sources, sub)
 	})
 }
 
 // Handle will publish giving type to all internal EventDistributor who are
 // expected to convert the needed interface{} into expected type for consumption
-// for their internal state or operations.
+// for their internal state or operations. The event is delivered according to
+// the Delivery of the Notifications.
 func (n *Notifications) Handle(item interface{}) {
-	n.do(func() {
-		for _, source := range n.sources {
-			if source != nil {
-				source.Handle(item)
-			}
+	switch n.delivery {
+	case Queued:
+		n.ql.Lock()
+		n.queue = append(n.queue, item)
+		n.drain()
+	case Async:
+		n.ql.Lock()
+		defer n.ql.Unlock()
+
+		if n.closed {
+			return
 		}
-	})
+
+		n.queue = append(n.queue, item)
+
+		if !n.started {
+			n.started = true
+			go n.run()
+		}
+
+		n.qc.Broadcast()
+	default:
+		n.deliver(item)
+	}
+}
+
+// Wait blocks till all queued events are delivered. It must not be called by
+// listeners during delivery, which would never return.
+func (n *Notifications) Wait() {
+	n.ql.Lock()
+	defer n.ql.Unlock()
+
+	for len(n.queue) != 0 || n.delivering {
+		n.qc.Wait()
+	}
+}
+
+// Close stops the delivery goroutine of a Async Notifications once all queued
+// events are delivered, events dispatched after Close are dropped.
+func (n *Notifications) Close() {
+	n.ql.Lock()
+	defer n.ql.Unlock()
+
+	n.closed = true
+	n.qc.Broadcast()
+}
+
+// deliver delivers the item to a snapshot of the listeners, skipping those
+// removed during delivery.
+func (n *Notifications) deliver(item interface{}) {
+	n.ml.Lock()
+	sources := n.sources
+	n.ml.Unlock()
+
+	for _, source := range sources {
+		if atomic.LoadInt32(&source.removed) == 0 {
+			source.dist.Handle(item)
+		}
+	}
+}
+
+// drain delivers the queued events till the queue is empty, unless events are
+// already being delivered. It must be called with the queue locked, which it
+// unlocks.
+func (n *Notifications) drain() {
+	if n.delivering {
+		n.ql.Unlock()
+		return
+	}
+
+	n.delivering = true
+
+	// Reset delivering if a listener panics, leaving the remaining events to
+	// the next dispatch.
+	done := false
+	defer func() {
+		if done {
+			return
+		}
+
+		n.ql.Lock()
+		n.delivering = false
+		n.qc.Broadcast()
+		n.ql.Unlock()
+	}()
+
+	for len(n.queue) != 0 {
+		item := n.queue[0]
+		n.queue[0] = nil
+		n.queue = n.queue[1:]
+
+		n.ql.Unlock()
+		n.deliver(item)
+		n.ql.Lock()
+	}
+
+	done = true
+	n.delivering = false
+	n.qc.Broadcast()
+	n.ql.Unlock()
+}
+
+// run delivers the queued events of a Async Notifications till it is closed.
+func (n *Notifications) run() {
+	n.ql.Lock()
+	for {
+		for len(n.queue) == 0 && !n.closed {
+			n.qc.Wait()
+		}
+
+		if len(n.queue) == 0 {
+			n.started = false
+			n.ql.Unlock()
+			return
+		}
+
+		item := n.queue[0]
+		n.queue[0] = nil
+		n.queue = n.queue[1:]
+		n.delivering = true
+
+		n.ql.Unlock()
+		n.deliver(item)
+		n.ql.Lock()
+
+		n.delivering = false
+		n.qc.Broadcast()
+	}
 }
 
 // do performs the needed function call guarded by a mutex call block.
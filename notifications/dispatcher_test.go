@@ -0,0 +1,223 @@
+package notifications_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/gu-io/gu/notifications"
+)
+
+var success = "✓"
+var failed = "✗"
+
+// listener defines a EventDistributor which calls a function.
+type listener struct {
+	fn func(interface{})
+}
+
+func (l *listener) Handle(item interface{}) {
+	l.fn(item)
+}
+
+// recorder defines a EventDistributor which records the items received.
+type recorder struct {
+	ml    sync.Mutex
+	items []interface{}
+}
+
+func (r *recorder) Handle(item interface{}) {
+	r.ml.Lock()
+	defer r.ml.Unlock()
+	r.items = append(r.items, item)
+}
+
+func (r *recorder) Items() []interface{} {
+	r.ml.Lock()
+	defer r.ml.Unlock()
+	return append([]interface{}(nil), r.items...)
+}
+
+func TestNestedDispatch(t *testing.T) {
+	dispatch := notifications.New()
+
+	var order []interface{}
+	var added bool
+
+	late := &recorder{}
+
+	dispatch.Subscribe(&listener{fn: func(item interface{}) {
+		order = append(order, item)
+
+		if item == 1 {
+			dispatch.Dispatch(2)
+		}
+
+		// Subscribing during delivery must not receive the current event.
+		if !added {
+			added = true
+			dispatch.Subscribe(late)
+		}
+	}})
+
+	dispatch.Dispatch(1)
+
+	if !reflect.DeepEqual(order, []interface{}{1, 2}) {
+		t.Fatalf("\t%s\t Should have delivered nested dispatch: %+v", failed, order)
+	}
+	t.Logf("\t%s\t Should have delivered nested dispatch", success)
+
+	if items := late.Items(); len(items) != 0 {
+		t.Fatalf("\t%s\t Should have not delivered current event to listener subscribed during delivery: %+v", failed, items)
+	}
+	t.Logf("\t%s\t Should have not delivered current event to listener subscribed during delivery", success)
+
+	dispatch.Dispatch(3)
+
+	if items := late.Items(); !reflect.DeepEqual(items, []interface{}{3}) {
+		t.Fatalf("\t%s\t Should have delivered later event to listener subscribed during delivery: %+v", failed, items)
+	}
+	t.Logf("\t%s\t Should have delivered later event to listener subscribed during delivery", success)
+}
+
+func TestUnsubscribeDuringDispatch(t *testing.T) {
+	dispatch := notifications.New()
+
+	second := &recorder{}
+	third := &recorder{}
+
+	var remover interface {
+		Remove()
+	}
+
+	dispatch.Subscribe(&listener{fn: func(item interface{}) {
+		remover.Remove()
+	}})
+
+	remover = dispatch.SubscribeWithRemover(second)
+	dispatch.Subscribe(third)
+
+	dispatch.Dispatch(1)
+
+	if items := second.Items(); len(items) != 0 {
+		t.Fatalf("\t%s\t Should have not delivered event to listener removed during delivery: %+v", failed, items)
+	}
+	t.Logf("\t%s\t Should have not delivered event to listener removed during delivery", success)
+
+	if items := third.Items(); !reflect.DeepEqual(items, []interface{}{1}) {
+		t.Fatalf("\t%s\t Should have delivered event to remaining listener: %+v", failed, items)
+	}
+	t.Logf("\t%s\t Should have delivered event to remaining listener", success)
+}
+
+func TestUnsubscribe(t *testing.T) {
+	dispatch := notifications.New()
+
+	listeners := []*recorder{{}, {}, {}, {}}
+	for _, item := range listeners {
+		dispatch.Subscribe(item)
+	}
+
+	dispatch.Unsubscribe(listeners[0])
+	dispatch.Unsubscribe(listeners[2])
+	dispatch.Unsubscribe(listeners[2])
+
+	dispatch.Dispatch(1)
+
+	for index, item := range listeners {
+		expected := 1
+		if index == 0 || index == 2 {
+			expected = 0
+		}
+
+		if len(item.Items()) != expected {
+			t.Fatalf("\t%s\t Should have delivered %d events to listener %d: %+v", failed, expected, index, item.Items())
+		}
+	}
+	t.Logf("\t%s\t Should have delivered events only to subscribed listeners", success)
+}
+
+func TestQueuedDispatch(t *testing.T) {
+	dispatch := notifications.NewWithDelivery(notifications.Queued)
+
+	var first []interface{}
+	var second []interface{}
+
+	dispatch.Subscribe(&listener{fn: func(item interface{}) {
+		first = append(first, item)
+
+		if item == 1 {
+			dispatch.Dispatch(2)
+			dispatch.Dispatch(3)
+		}
+	}})
+
+	dispatch.Subscribe(&listener{fn: func(item interface{}) {
+		second = append(second, item)
+	}})
+
+	dispatch.Dispatch(1)
+
+	expected := []interface{}{1, 2, 3}
+
+	if !reflect.DeepEqual(first, expected) || !reflect.DeepEqual(second, expected) {
+		t.Fatalf("\t%s\t Should have delivered queued events in order: %+v, %+v", failed, first, second)
+	}
+	t.Logf("\t%s\t Should have delivered queued events in order", success)
+}
+
+func TestAsyncDispatch(t *testing.T) {
+	dispatch := notifications.NewWithDelivery(notifications.Async)
+	defer dispatch.Close()
+
+	events := &recorder{}
+	dispatch.Subscribe(events)
+
+	var expected []interface{}
+	for index := 0; index < 100; index++ {
+		expected = append(expected, index)
+		dispatch.Dispatch(index)
+	}
+
+	dispatch.Wait()
+
+	if items := events.Items(); !reflect.DeepEqual(items, expected) {
+		t.Fatalf("\t%s\t Should have delivered async events in order: %+v", failed, items)
+	}
+	t.Logf("\t%s\t Should have delivered async events in order", success)
+}
+
+func TestConcurrentDispatch(t *testing.T) {
+	for _, delivery := range []notifications.Delivery{notifications.Immediate, notifications.Queued, notifications.Async} {
+		dispatch := notifications.NewWithDelivery(delivery)
+
+		events := &recorder{}
+		dispatch.Subscribe(events)
+
+		var wg sync.WaitGroup
+
+		for worker := 0; worker < 8; worker++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for index := 0; index < 50; index++ {
+					item := &recorder{}
+					remover := dispatch.SubscribeWithRemover(item)
+					dispatch.Dispatch(index)
+					remover.Remove()
+				}
+			}()
+		}
+
+		wg.Wait()
+		dispatch.Wait()
+		dispatch.Close()
+
+		if total := len(events.Items()); total != 400 {
+			t.Fatalf("\t%s\t Should have delivered all concurrent events with delivery %d: %d", failed, delivery, total)
+		}
+	}
+	t.Logf("\t%s\t Should have delivered all concurrent events", success)
+}
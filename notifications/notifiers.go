@@ -17,11 +17,17 @@ func (sn *AppEventNotification) Deliver(uuid string, item interface{}) {
 // AppNotification defines a structure which provides a local notification
 // framework for the pubsub.
 func AppNotification(uid string) *AppEventNotification {
+	return dispatch.AppNotification(uid)
+}
+
+// AppNotification returns a AppEventNotification subscribed to the dispatcher,
+// which receives the AppEvent delivered for the giving uid.
+func (n *Notifications) AppNotification(uid string) *AppEventNotification {
 	app := NewAppEventNotificationWith(func(ev AppEvent) bool {
 		return ev.UUID == uid
 	})
 
-	Subscribe(app)
+	n.Subscribe(app)
 
 	return app
 }
@@ -2,12 +2,16 @@ package cache
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 )
 
+// ErrNotFound is returned when a cache has no response for a request.
+var ErrNotFound = errors.New("Request not found")
+
 // WebPair defines a struct which contains the request object and response object
 // received for that request.
 type WebPair struct {
@@ -16,7 +20,9 @@ type WebPair struct {
 }
 
 // Cache defines a interface which exposes a cache like structure for retrieving
-// requests.
+// requests. Paths given to a Cache identify GET requests and are matched by
+// their Key, so equivalent urls match the same response. Get, Delete and Serve
+// return ErrNotFound when no response matches.
 type Cache interface {
 	Empty() error
 	Delete(string) error
@@ -51,7 +57,7 @@ type Response struct {
 // HTTPRequestToRequest transforms a giving request object into a cache.Request
 // object.
 func HTTPRequestToRequest(req *http.Request) *Request {
-	var rq *Request
+	rq := new(Request)
 	rq.URL = req.URL
 	rq.Path = req.URL.String()
 	rq.Method = req.Method
@@ -71,9 +77,10 @@ func HTTPResponseToResponse(res *http.Response) (*Response, *Request) {
 	}
 
 	var rq *Request
-	var wq *Response
+	wq := new(Response)
 
 	if res.Request != nil {
+		rq = new(Request)
 		rq.URL = res.Request.URL
 		rq.Path = res.Request.URL.String()
 		rq.Method = res.Request.Method
@@ -90,6 +97,27 @@ func HTTPResponseToResponse(res *http.Response) (*Response, *Request) {
 	return wq, rq
 }
 
+// ServeResponse writes the status, headers and body of the response into the
+// http.ResponseWriter. Responses without a status are written as 200 OK, or 204
+// No Content when they have no body.
+func ServeResponse(w http.ResponseWriter, res Response) {
+	for name, value := range res.Headers {
+		w.Header().Set(name, value)
+	}
+
+	status := res.Status
+	if status == 0 {
+		status = http.StatusOK
+
+		if res.Body.Len() == 0 {
+			status = http.StatusNoContent
+		}
+	}
+
+	w.WriteHeader(status)
+	w.Write(res.Body.Bytes())
+}
+
 func cookies(cookies []*http.Cookie) []string {
 	var co []string
 
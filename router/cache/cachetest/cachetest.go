@@ -0,0 +1,259 @@
+// Package cachetest provides the conformance tests every cache.Cache
+// implementation must pass, to be run from the tests of each implementation.
+package cachetest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gu-io/gu/router/cache"
+)
+
+var success = "✓"
+var failed = "✗"
+
+// Conformance runs the conformance tests against caches returned by the giving
+// function, which must return a new empty cache for each call.
+func Conformance(t *testing.T, newCache func() cache.Cache) {
+	t.Run("GetMissing", func(t *testing.T) { testGetMissing(t, newCache()) })
+	t.Run("AddData", func(t *testing.T) { testAddData(t, newCache()) })
+	t.Run("Add", func(t *testing.T) { testAdd(t, newCache()) })
+	t.Run("AddRedirected", func(t *testing.T) { testAddRedirected(t, newCache()) })
+	t.Run("Replace", func(t *testing.T) { testReplace(t, newCache()) })
+	t.Run("Delete", func(t *testing.T) { testDelete(t, newCache()) })
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, newCache()) })
+	t.Run("NormalizedKeys", func(t *testing.T) { testNormalizedKeys(t, newCache()) })
+	t.Run("Serve", func(t *testing.T) { testServe(t, newCache()) })
+	t.Run("Concurrent", func(t *testing.T) { testConcurrent(t, newCache()) })
+}
+
+// newResponse returns a response for a GET request of the path.
+func newResponse(path string, status int, body string, header http.Header) *http.Response {
+	req, _ := http.NewRequest("GET", path, nil)
+
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		Request:    req,
+	}
+}
+
+func testGetMissing(t *testing.T, c cache.Cache) {
+	if _, _, err := c.Get("/missing"); err != cache.ErrNotFound {
+		t.Fatalf("\t%s\t Should have returned cache.ErrNotFound for missing path: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have returned cache.ErrNotFound for missing path", success)
+
+	if err := c.Delete("/missing"); err != cache.ErrNotFound {
+		t.Fatalf("\t%s\t Should have returned cache.ErrNotFound deleting missing path: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have returned cache.ErrNotFound deleting missing path", success)
+}
+
+func testAddData(t *testing.T, c cache.Cache) {
+	if err := c.AddData("/data.json", []byte(`{"id":1}`)); err != nil {
+		t.Fatalf("\t%s\t Should have added data: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have added data", success)
+
+	_, res, err := c.Get("/data.json")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have retrieved data: %q", failed, err.Error())
+	}
+
+	if res.Body.String() != `{"id":1}` {
+		t.Fatalf("\t%s\t Should have retrieved added data: %q", failed, res.Body.String())
+	}
+	t.Logf("\t%s\t Should have retrieved added data", success)
+}
+
+func testAdd(t *testing.T, c cache.Cache) {
+	header := make(http.Header)
+	header.Set("Content-Type", "text/plain")
+	header.Set("ETag", `"v1"`)
+
+	if err := c.Add("/resource", newResponse("/resource", http.StatusCreated, "resource", header)); err != nil {
+		t.Fatalf("\t%s\t Should have added response: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have added response", success)
+
+	req, res, err := c.Get("/resource")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have retrieved response: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have retrieved response", success)
+
+	if res.Status != http.StatusCreated || res.Body.String() != "resource" {
+		t.Fatalf("\t%s\t Should have retrieved status and body of response: %d %q", failed, res.Status, res.Body.String())
+	}
+	t.Logf("\t%s\t Should have retrieved status and body of response", success)
+
+	if res.Headers["Content-Type"] != "text/plain" || res.Headers["Etag"] != `"v1"` {
+		t.Fatalf("\t%s\t Should have retrieved headers of response: %+q", failed, res.Headers)
+	}
+	t.Logf("\t%s\t Should have retrieved headers of response", success)
+
+	if req.Method != "GET" || req.Path != "/resource" {
+		t.Fatalf("\t%s\t Should have retrieved request of response: %q %q", failed, req.Method, req.Path)
+	}
+	t.Logf("\t%s\t Should have retrieved request of response", success)
+}
+
+func testAddRedirected(t *testing.T, c cache.Cache) {
+	// The request of a redirected response is of the url redirected to.
+	c.Add("/moved", newResponse("/moved/to", http.StatusOK, "moved", nil))
+
+	_, res, err := c.Get("/moved")
+	if err != nil || res.Body.String() != "moved" {
+		t.Fatalf("\t%s\t Should have retrieved response by path it was added with: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have retrieved response by path it was added with", success)
+
+	if err := c.Delete("/moved"); err != nil {
+		t.Fatalf("\t%s\t Should have deleted response by path it was added with: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have deleted response by path it was added with", success)
+}
+
+func testReplace(t *testing.T, c cache.Cache) {
+	c.Add("/resource", newResponse("/resource", http.StatusOK, "first", nil))
+	c.Add("/resource", newResponse("/resource", http.StatusOK, "second", nil))
+
+	_, res, err := c.Get("/resource")
+	if err != nil || res.Body.String() != "second" {
+		t.Fatalf("\t%s\t Should have replaced response for path: %q", failed, res.Body.String())
+	}
+	t.Logf("\t%s\t Should have replaced response for path", success)
+
+	c.Delete("/resource")
+
+	if _, _, err := c.Get("/resource"); err != cache.ErrNotFound {
+		t.Fatalf("\t%s\t Should have kept a single response for path: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have kept a single response for path", success)
+}
+
+func testDelete(t *testing.T, c cache.Cache) {
+	c.AddData("/first", []byte("first"))
+	c.AddData("/second", []byte("second"))
+
+	if err := c.Delete("/first"); err != nil {
+		t.Fatalf("\t%s\t Should have deleted path: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have deleted path", success)
+
+	if _, _, err := c.Get("/first"); err != cache.ErrNotFound {
+		t.Fatalf("\t%s\t Should have removed deleted path: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have removed deleted path", success)
+
+	if _, _, err := c.Get("/second"); err != nil {
+		t.Fatalf("\t%s\t Should have kept other path: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have kept other path", success)
+}
+
+func testEmpty(t *testing.T, c cache.Cache) {
+	c.AddData("/first", []byte("first"))
+	c.AddData("/second", []byte("second"))
+
+	if err := c.Empty(); err != nil {
+		t.Fatalf("\t%s\t Should have emptied cache: %q", failed, err.Error())
+	}
+
+	for _, path := range []string{"/first", "/second"} {
+		if _, _, err := c.Get(path); err != cache.ErrNotFound {
+			t.Fatalf("\t%s\t Should have removed %q from emptied cache: %+q", failed, path, err)
+		}
+	}
+	t.Logf("\t%s\t Should have emptied cache", success)
+}
+
+func testNormalizedKeys(t *testing.T, c cache.Cache) {
+	c.AddData("http://Example.com:80/search?b=2&a=1#results", []byte("search"))
+
+	for _, path := range []string{
+		"http://example.com/search?a=1&b=2",
+		"HTTP://EXAMPLE.COM/search?b=2&a=1",
+	} {
+		if _, res, err := c.Get(path); err != nil || res.Body.String() != "search" {
+			t.Fatalf("\t%s\t Should have matched equivalent url %q: %+q", failed, path, err)
+		}
+	}
+	t.Logf("\t%s\t Should have matched equivalent urls", success)
+
+	if _, _, err := c.Get("http://example.com/search?a=1"); err != cache.ErrNotFound {
+		t.Fatalf("\t%s\t Should have not matched different url: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have not matched different url", success)
+
+	if err := c.Delete("http://example.com/search?a=1&b=2"); err != nil {
+		t.Fatalf("\t%s\t Should have deleted equivalent url: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have deleted equivalent url", success)
+}
+
+func testServe(t *testing.T, c cache.Cache) {
+	header := make(http.Header)
+	header.Set("Content-Type", "text/plain")
+
+	c.Add("/resource", newResponse("/resource", http.StatusAccepted, "resource", header))
+
+	recorder := httptest.NewRecorder()
+	if err := c.Serve(recorder, httptest.NewRequest("GET", "/resource", nil)); err != nil {
+		t.Fatalf("\t%s\t Should have served response: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have served response", success)
+
+	if recorder.Code != http.StatusAccepted || recorder.Body.String() != "resource" || recorder.Header().Get("Content-Type") != "text/plain" {
+		t.Fatalf("\t%s\t Should have served status, headers and body of response: %d %q", failed, recorder.Code, recorder.Body.String())
+	}
+	t.Logf("\t%s\t Should have served status, headers and body of response", success)
+
+	if err := c.Serve(httptest.NewRecorder(), httptest.NewRequest("GET", "/missing", nil)); err != cache.ErrNotFound {
+		t.Fatalf("\t%s\t Should have returned cache.ErrNotFound serving missing path: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have returned cache.ErrNotFound serving missing path", success)
+}
+
+func testConcurrent(t *testing.T, c cache.Cache) {
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+
+		go func(worker int) {
+			defer wg.Done()
+
+			for index := 0; index < 20; index++ {
+				path := fmt.Sprintf("/worker/%d/%d", worker, index)
+
+				c.AddData(path, []byte(path))
+				c.Get(path)
+				c.Add(path, newResponse(path, http.StatusOK, path, nil))
+				c.Serve(httptest.NewRecorder(), httptest.NewRequest("GET", path, nil))
+			}
+		}(worker)
+	}
+
+	wg.Wait()
+
+	for worker := 0; worker < 8; worker++ {
+		path := fmt.Sprintf("/worker/%d/19", worker)
+
+		if _, res, err := c.Get(path); err != nil || res.Body.String() != path {
+			t.Fatalf("\t%s\t Should have kept responses added concurrently: %q", failed, path)
+		}
+	}
+	t.Logf("\t%s\t Should have kept responses added concurrently", success)
+}
@@ -0,0 +1,586 @@
+// Package filecache implements the cache.Cache interface, persisting responses
+// into a directory.
+//
+// Bodies of responses are stored by the sha256 hash of their content in the
+// bodies directory, so equal bodies are stored once, while the request and
+// response details are stored as json in the entries directory. All files are
+// written into temporary files and renamed into place, so other processes
+// reading the directory never see partial files.
+package filecache
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gu-io/gu/router/cache"
+)
+
+const (
+	bodiesDir  = "bodies"
+	entriesDir = "entries"
+	tempPrefix = ".tmp-"
+)
+
+// Option defines a function type used to configure a API.
+type Option func(*API)
+
+// MaxEntries sets the maximum number of responses kept by the cache, the least
+// recently used responses are evicted once exceeded.
+func MaxEntries(max int) Option {
+	return func(a *API) {
+		a.maxEntries = max
+	}
+}
+
+// MaxSize sets the maximum total size in bytes of the bodies stored by the
+// cache, the least recently used responses are evicted once exceeded.
+func MaxSize(max int64) Option {
+	return func(a *API) {
+		a.maxSize = max
+	}
+}
+
+// record defines the json stored for a response in the entries directory.
+type record struct {
+	Key             string            `json:"key"`
+	Method          string            `json:"method"`
+	Path            string            `json:"path"`
+	Headers         map[string]string `json:"headers"`
+	Status          int               `json:"status"`
+	Type            string            `json:"type"`
+	ResponseMethod  string            `json:"response_method"`
+	ResponseHeaders map[string]string `json:"response_headers"`
+	Cookies         []string          `json:"cookies"`
+	Body            string            `json:"body"`
+	Size            int64             `json:"size"`
+}
+
+// API defines a structure which implements the cache.Cache interface, keeping
+// responses in a directory indexed by their cache.Key. A directory should be
+// used by a single API at a time, other processes may read it safely.
+type API struct {
+	dir        string
+	maxEntries int
+	maxSize    int64
+
+	ml      sync.Mutex
+	size    int64
+	lru     *list.List
+	entries map[string]*list.Element
+	bodies  map[string]int
+}
+
+// New returns a new instance of the API struct using the directory, which is
+// created if it does not exists. Responses already stored in the directory are
+// loaded into the cache.
+func New(dir string, options ...Option) (*API, error) {
+	a := &API{
+		dir:     dir,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+		bodies:  make(map[string]int),
+	}
+
+	for _, option := range options {
+		option(a)
+	}
+
+	if err := a.load(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Dir returns the directory of the cache.
+func (a *API) Dir() string {
+	return a.dir
+}
+
+// String returns a json version of the internal array of pairs.
+func (a *API) String() string {
+	pairs, _ := a.All()
+
+	jsx, err := json.Marshal(pairs)
+	if err != nil {
+		return ""
+	}
+
+	return string(jsx)
+}
+
+// Len returns the total number of responses in the cache.
+func (a *API) Len() int {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	return a.lru.Len()
+}
+
+// Size returns the total size in bytes of the bodies stored by the cache.
+func (a *API) Size() int64 {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	return a.size
+}
+
+// Empty deletes all giving requests from the underline cache.
+func (a *API) Empty() error {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	for elem := a.lru.Front(); elem != nil; {
+		next := elem.Next()
+
+		if err := a.remove(elem); err != nil {
+			return err
+		}
+
+		elem = next
+	}
+
+	return nil
+}
+
+// All returns all the pairs of requests which have been added into the cache,
+// from the most recently used.
+func (a *API) All() ([]cache.WebPair, error) {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	var pairs []cache.WebPair
+	for elem := a.lru.Front(); elem != nil; elem = elem.Next() {
+		pair, err := a.pair(elem.Value.(*record))
+		if err != nil {
+			return pairs, err
+		}
+
+		pairs = append(pairs, pair)
+	}
+
+	return pairs, nil
+}
+
+// DeleteRequest removes the underline request from the cache.
+func (a *API) DeleteRequest(w cache.Request) error {
+	return a.deleteKey(w.Key().String())
+}
+
+// Delete removes the giving path from the underline cache if found.
+func (a *API) Delete(path string) error {
+	return a.deleteKey(cache.PathKey(path).String())
+}
+
+// AddData adds the giving data object into the cache.
+func (a *API) AddData(req string, res []byte) error {
+	return a.PutPath(req, cache.Response{Method: "GET", Body: *bytes.NewBuffer(res)})
+}
+
+// Add adds the giving response object into the cache, replacing any response
+// for the path.
+func (a *API) Add(req string, res *http.Response) error {
+	resp, reqs := cache.HTTPResponseToResponse(res)
+	if reqs == nil {
+		reqs = &cache.Request{Path: req, Method: "GET"}
+	}
+
+	return a.put(cache.PathKey(req).String(), *reqs, *resp)
+}
+
+// Serve attempts to find the request and serve the response into the provided
+// http.ResponseWriter.
+func (a *API) Serve(w http.ResponseWriter, r *http.Request) error {
+	// 1. Attempt to get full URI
+	// 2. Attempt to get only path
+
+	_, res, err := a.Get(r.URL.String())
+	if err != nil {
+		_, res, err = a.Get(r.URL.Path)
+		if err != nil {
+			return err
+		}
+	}
+
+	cache.ServeResponse(w, res)
+	return nil
+}
+
+// Put adds the response into the cache for the request, replacing any response
+// for the request.
+func (a *API) Put(req cache.Request, res cache.Response) error {
+	return a.put(req.Key().String(), req, res)
+}
+
+// PutPath adds the response into the cache for the path, replacing any
+// response for the path.
+func (a *API) PutPath(path string, res cache.Response) error {
+	var req cache.Request
+	req.Path = path
+	req.Method = "GET"
+
+	uri, _ := url.Parse(path)
+	req.URL = uri
+
+	return a.Put(req, res)
+}
+
+// GetRequest returns the response for the request.
+func (a *API) GetRequest(w cache.Request) (cache.Response, error) {
+	pair, err := a.getKey(w.Key().String())
+	if err != nil {
+		return cache.Response{}, err
+	}
+
+	return pair.Response, nil
+}
+
+// Get returns the request and response for the path.
+func (a *API) Get(path string) (cache.Request, cache.Response, error) {
+	pair, err := a.getKey(cache.PathKey(path).String())
+	if err != nil {
+		return cache.Request{
+			Path:   path,
+			Method: "GET",
+		}, cache.Response{}, err
+	}
+
+	return pair.Request, pair.Response, nil
+}
+
+// Seed adds the files of an asset bundle generated by assets.Webpack.Compile
+// into the cache under the prefix, reading them with the ReadFileByte function
+// of the bundle. The Content-Type of each file is set from it's extension.
+//
+//	err := fc.Seed("/assets", bundle.FilesFor(".css"), bundle.ReadFileByte)
+func (a *API) Seed(prefix string, files []string, read func(string, bool) ([]byte, error)) error {
+	for _, file := range files {
+		data, err := read(file, true)
+		if err != nil {
+			return err
+		}
+
+		var res cache.Response
+		res.Method = "GET"
+		res.Status = http.StatusOK
+		res.Body = *bytes.NewBuffer(data)
+		res.Headers = make(map[string]string)
+
+		if ctype := mime.TypeByExtension(path.Ext(file)); ctype != "" {
+			res.Headers["Content-Type"] = ctype
+		}
+
+		if err := a.PutPath(path.Join("/", prefix, filepath.ToSlash(file)), res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getKey returns the pair for the key, marking it as recently used.
+func (a *API) getKey(key string) (cache.WebPair, error) {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	elem, ok := a.entries[key]
+	if !ok {
+		return cache.WebPair{}, cache.ErrNotFound
+	}
+
+	item := elem.Value.(*record)
+
+	pair, err := a.pair(item)
+	if err != nil {
+		// The files of the response were removed or damaged outside of the
+		// cache, so the response is dropped.
+		a.remove(elem)
+		return cache.WebPair{}, cache.ErrNotFound
+	}
+
+	a.lru.MoveToFront(elem)
+
+	// The modification time of entries keeps the order of use across loads.
+	now := time.Now()
+	os.Chtimes(a.entryPath(item.Key), now, now)
+
+	return pair, nil
+}
+
+// deleteKey removes the pair for the key.
+func (a *API) deleteKey(key string) error {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	elem, ok := a.entries[key]
+	if !ok {
+		return cache.ErrNotFound
+	}
+
+	return a.remove(elem)
+}
+
+// put stores the request and response for the key, replacing any response for
+// the key and evicting the least recently used responses beyond the limits of
+// the cache. Responses whose body is larger than the maximum size of the cache
+// are not stored.
+func (a *API) put(key string, req cache.Request, res cache.Response) error {
+	body := res.Body.Bytes()
+
+	sum := sha256.Sum256(body)
+
+	item := &record{
+		Key:             key,
+		Method:          req.Method,
+		Path:            req.Path,
+		Headers:         req.Headers,
+		Status:          res.Status,
+		Type:            res.Type,
+		ResponseMethod:  res.Method,
+		ResponseHeaders: res.Headers,
+		Cookies:         res.Cookies,
+		Body:            hex.EncodeToString(sum[:]),
+		Size:            int64(len(body)),
+	}
+
+	if item.Path == "" && req.URL != nil {
+		item.Path = req.URL.String()
+	}
+
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	if elem, ok := a.entries[key]; ok {
+		if err := a.remove(elem); err != nil {
+			return err
+		}
+	}
+
+	// Responses larger than the cache are never kept.
+	if a.maxSize > 0 && item.Size > a.maxSize {
+		return nil
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	if a.bodies[item.Body] == 0 {
+		if err := writeFile(a.bodyPath(item.Body), body); err != nil {
+			return err
+		}
+	}
+
+	if err := writeFile(a.entryPath(key), data); err != nil {
+		if a.bodies[item.Body] == 0 {
+			os.Remove(a.bodyPath(item.Body))
+		}
+
+		return err
+	}
+
+	a.add(item)
+
+	return a.evict()
+}
+
+// add indexes the record as the most recently used.
+func (a *API) add(item *record) {
+	a.entries[item.Key] = a.lru.PushFront(item)
+
+	if a.bodies[item.Body] == 0 {
+		a.size += item.Size
+	}
+
+	a.bodies[item.Body]++
+}
+
+// evict removes the least recently used responses beyond the limits of the
+// cache.
+func (a *API) evict() error {
+	for (a.maxEntries > 0 && a.lru.Len() > a.maxEntries) || (a.maxSize > 0 && a.size > a.maxSize) {
+		if err := a.remove(a.lru.Back()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// remove removes the element from the cache, deleting it's body once no other
+// response uses it.
+func (a *API) remove(elem *list.Element) error {
+	item := elem.Value.(*record)
+
+	a.lru.Remove(elem)
+	delete(a.entries, item.Key)
+
+	a.bodies[item.Body]--
+
+	if err := os.Remove(a.entryPath(item.Key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return a.release(item.Body, item.Size)
+}
+
+// release deletes the body once no response uses it.
+func (a *API) release(body string, size int64) error {
+	if a.bodies[body] > 0 {
+		return nil
+	}
+
+	delete(a.bodies, body)
+	a.size -= size
+
+	if err := os.Remove(a.bodyPath(body)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// pair returns the request and response of the record, reading it's body.
+func (a *API) pair(item *record) (cache.WebPair, error) {
+	body, err := ioutil.ReadFile(a.bodyPath(item.Body))
+	if err != nil {
+		return cache.WebPair{}, err
+	}
+
+	if int64(len(body)) != item.Size {
+		return cache.WebPair{}, cache.ErrNotFound
+	}
+
+	var pair cache.WebPair
+	pair.Request.Method = item.Method
+	pair.Request.Path = item.Path
+	pair.Request.Headers = item.Headers
+	pair.Request.URL, _ = url.Parse(item.Path)
+
+	pair.Response.Status = item.Status
+	pair.Response.Type = item.Type
+	pair.Response.Method = item.ResponseMethod
+	pair.Response.Headers = item.ResponseHeaders
+	pair.Response.Cookies = item.Cookies
+	pair.Response.Body = *bytes.NewBuffer(body)
+
+	return pair, nil
+}
+
+// load creates the directories of the cache and indexes the responses stored
+// in them from the least recently used. Temporary files left by interrupted
+// writes, damaged entries and unused bodies are removed.
+func (a *API) load() error {
+	for _, dir := range []string{bodiesDir, entriesDir} {
+		if err := os.MkdirAll(filepath.Join(a.dir, dir), 0755); err != nil {
+			return err
+		}
+	}
+
+	infos, err := ioutil.ReadDir(filepath.Join(a.dir, entriesDir))
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ModTime().Before(infos[j].ModTime())
+	})
+
+	for _, info := range infos {
+		file := filepath.Join(a.dir, entriesDir, info.Name())
+
+		if info.IsDir() {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		// Temporary files of interrupted writes never match their key.
+		var item record
+		if err := json.Unmarshal(data, &item); err != nil || a.entryPath(item.Key) != file {
+			os.Remove(file)
+			continue
+		}
+
+		if stat, err := os.Stat(a.bodyPath(item.Body)); err != nil || stat.Size() != item.Size {
+			os.Remove(file)
+			continue
+		}
+
+		a.add(&item)
+	}
+
+	bodies, err := ioutil.ReadDir(filepath.Join(a.dir, bodiesDir))
+	if err != nil {
+		return err
+	}
+
+	for _, info := range bodies {
+		if a.bodies[info.Name()] == 0 {
+			os.Remove(filepath.Join(a.dir, bodiesDir, info.Name()))
+		}
+	}
+
+	return a.evict()
+}
+
+// entryPath returns the path of the file storing the record of the key.
+func (a *API) entryPath(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(a.dir, entriesDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// bodyPath returns the path of the file storing the body with the hash.
+func (a *API) bodyPath(hash string) string {
+	return filepath.Join(a.dir, bodiesDir, hash)
+}
+
+// writeFile writes the data into a temporary file in the directory of the
+// file which is then renamed to it, replacing the file atomically.
+func writeFile(file string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(file), tempPrefix)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package filecache_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gu-io/gu/router/cache"
+	"github.com/gu-io/gu/router/cache/cachetest"
+	"github.com/gu-io/gu/router/cache/filecache"
+	"github.com/influx6/faux/tests"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "filecache")
+	if err != nil {
+		tests.Failed("Should have created temporary directory: %+q", err)
+	}
+
+	return dir
+}
+
+func files(dir string) []string {
+	infos, _ := ioutil.ReadDir(dir)
+
+	var names []string
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+
+	return names
+}
+
+func TestConformance(t *testing.T) {
+	var dirs []string
+	defer func() {
+		for _, dir := range dirs {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	cachetest.Conformance(t, func() cache.Cache {
+		dir := tempDir(t)
+		dirs = append(dirs, dir)
+
+		fc, err := filecache.New(dir)
+		if err != nil {
+			tests.Failed("Should have created cache: %+q", err)
+		}
+
+		return fc
+	})
+}
+
+func TestPersistence(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	fc, err := filecache.New(dir)
+	if err != nil {
+		tests.Failed("Should have created cache: %+q", err)
+	}
+	tests.Passed("Should have created cache")
+
+	fc.PutPath("/index.html", cache.Response{
+		Status:  200,
+		Headers: map[string]string{"Content-Type": "text/html"},
+	})
+	fc.AddData("/first", []byte("shared"))
+	fc.AddData("/second", []byte("shared"))
+
+	if bodies := files(filepath.Join(dir, "bodies")); len(bodies) != 2 {
+		tests.Failed("Should have stored equal bodies once: %+q", bodies)
+	}
+	tests.Passed("Should have stored equal bodies once")
+
+	reopened, err := filecache.New(dir)
+	if err != nil {
+		tests.Failed("Should have reopened cache: %+q", err)
+	}
+
+	_, res, err := reopened.Get("/second")
+	if err != nil || res.Body.String() != "shared" {
+		tests.Failed("Should have loaded stored response: %+q", err)
+	}
+	tests.Passed("Should have loaded stored response")
+
+	if _, res, err = reopened.Get("/index.html"); err != nil || res.Headers["Content-Type"] != "text/html" || res.Status != 200 {
+		tests.Failed("Should have loaded status and headers of stored response: %+q", err)
+	}
+	tests.Passed("Should have loaded status and headers of stored response")
+
+	reopened.Delete("/first")
+
+	if bodies := files(filepath.Join(dir, "bodies")); len(bodies) != 2 {
+		tests.Failed("Should have kept body used by other response: %+q", bodies)
+	}
+	tests.Passed("Should have kept body used by other response")
+
+	reopened.Delete("/second")
+
+	if bodies := files(filepath.Join(dir, "bodies")); len(bodies) != 1 {
+		tests.Failed("Should have removed unused body: %+q", bodies)
+	}
+	tests.Passed("Should have removed unused body")
+}
+
+func TestDamagedFiles(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	fc, _ := filecache.New(dir)
+	fc.AddData("/first", []byte("first"))
+	fc.AddData("/second", []byte("second"))
+
+	ioutil.WriteFile(filepath.Join(dir, "entries", ".tmp-interrupted"), []byte(`{"key":`), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "bodies", ".tmp-interrupted"), []byte("partial"), 0644)
+
+	for _, body := range files(filepath.Join(dir, "bodies")) {
+		if data, _ := ioutil.ReadFile(filepath.Join(dir, "bodies", body)); string(data) == "first" {
+			os.Remove(filepath.Join(dir, "bodies", body))
+		}
+	}
+
+	reopened, err := filecache.New(dir)
+	if err != nil {
+		tests.Failed("Should have reopened cache with damaged files: %+q", err)
+	}
+	tests.Passed("Should have reopened cache with damaged files")
+
+	if _, _, err := reopened.Get("/first"); err != cache.ErrNotFound || reopened.Len() != 1 {
+		tests.Failed("Should have dropped response without body: %+q", err)
+	}
+	tests.Passed("Should have dropped response without body")
+
+	if entries, bodies := files(filepath.Join(dir, "entries")), files(filepath.Join(dir, "bodies")); len(entries) != 1 || len(bodies) != 1 {
+		tests.Failed("Should have removed damaged files: %+q %+q", entries, bodies)
+	}
+	tests.Passed("Should have removed damaged files")
+}
+
+func TestMaxSize(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	fc, _ := filecache.New(dir, filecache.MaxSize(10))
+
+	fc.AddData("/first", []byte("12345"))
+	fc.AddData("/second", []byte("67890"))
+	fc.Get("/first")
+	fc.AddData("/third", []byte("abc"))
+
+	if _, _, err := fc.Get("/second"); err != cache.ErrNotFound {
+		tests.Failed("Should have evicted least recently used response: %+q", err)
+	}
+	tests.Passed("Should have evicted least recently used response")
+
+	if fc.Size() != 8 || fc.Len() != 2 {
+		tests.Failed("Should have tracked size of bodies: %d", fc.Size())
+	}
+	tests.Passed("Should have tracked size of bodies")
+
+	fc.AddData("/large", []byte("12345678901"))
+
+	if _, _, err := fc.Get("/large"); err != cache.ErrNotFound {
+		tests.Failed("Should have not stored response larger than cache: %+q", err)
+	}
+	tests.Passed("Should have not stored response larger than cache")
+
+	reopened, _ := filecache.New(dir, filecache.MaxEntries(1))
+
+	if reopened.Len() != 1 {
+		tests.Failed("Should have evicted stored responses beyond limits: %d", reopened.Len())
+	}
+	tests.Passed("Should have evicted stored responses beyond limits")
+}
+
+func TestSeed(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	fc, _ := filecache.New(dir)
+
+	bundle := map[string]string{
+		"css/main.css": "body{}",
+		"js/app.js":    "var app;",
+	}
+
+	err := fc.Seed("/assets", []string{"css/main.css", "js/app.js"}, func(path string, doGzip bool) ([]byte, error) {
+		data, ok := bundle[path]
+		if !ok {
+			return nil, fmt.Errorf("File %q not found in file system", path)
+		}
+
+		return []byte(data), nil
+	})
+	if err != nil {
+		tests.Failed("Should have seeded cache from bundle: %+q", err)
+	}
+	tests.Passed("Should have seeded cache from bundle")
+
+	_, res, err := fc.Get("/assets/css/main.css")
+	if err != nil || res.Body.String() != "body{}" {
+		tests.Failed("Should have added bundle files under prefix: %+q", err)
+	}
+	tests.Passed("Should have added bundle files under prefix")
+
+	if ctype := res.Headers["Content-Type"]; ctype != "text/css; charset=utf-8" {
+		tests.Failed("Should have set Content-Type of bundle files: %q", ctype)
+	}
+	tests.Passed("Should have set Content-Type of bundle files")
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Key defines the identity of a cached request, made of the request method, the
+// normalised url and the values of selected request headers. Requests which
+// differ only in the order of their query parameters, the case of their scheme
+// and host or a default port share the same Key.
+type Key struct {
+	Method  string
+	URL     string
+	Headers map[string]string
+}
+
+// NewKey returns the Key for the method and url, using the values of the named
+// headers. The method defaults to GET.
+func NewKey(method string, rawURL string, header http.Header, names ...string) Key {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if method == "" {
+		method = "GET"
+	}
+
+	key := Key{
+		Method: method,
+		URL:    NormalizeURL(rawURL),
+	}
+
+	for _, name := range names {
+		name = http.CanonicalHeaderKey(name)
+
+		if key.Headers == nil {
+			key.Headers = make(map[string]string)
+		}
+
+		key.Headers[name] = strings.Join(header[name], ";")
+	}
+
+	return key
+}
+
+// PathKey returns the Key for a GET request of the path.
+func PathKey(path string) Key {
+	return NewKey("GET", path, nil)
+}
+
+// HTTPRequestKey returns the Key for the http request, using the values of the
+// named headers.
+func HTTPRequestKey(r *http.Request, names ...string) Key {
+	return NewKey(r.Method, r.URL.String(), r.Header, names...)
+}
+
+// Key returns the Key for the request, using the values of the named headers.
+func (r Request) Key(names ...string) Key {
+	path := r.Path
+	if path == "" && r.URL != nil {
+		path = r.URL.String()
+	}
+
+	header := make(http.Header)
+	for name, value := range r.Headers {
+		header.Set(name, value)
+	}
+
+	return NewKey(r.Method, path, header, names...)
+}
+
+// String returns the string form of the Key, which is equal for equal keys.
+func (k Key) String() string {
+	if len(k.Headers) == 0 {
+		return k.Method + " " + k.URL
+	}
+
+	names := make([]string, 0, len(k.Headers))
+	for name := range k.Headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, name+"="+url.QueryEscape(k.Headers[name]))
+	}
+
+	return k.Method + " " + k.URL + " " + strings.Join(parts, "&")
+}
+
+// defaultPorts defines the default ports of url schemes, which are removed
+// from normalised urls.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+}
+
+// NormalizeURL returns the normalised form of the url, with a lowercase scheme
+// and host, no default port, no fragment and query parameters sorted by name. It
+// returns the url unchanged if it can not be parsed.
+func NormalizeURL(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return rawURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+
+	if port, ok := defaultPorts[u.Scheme]; ok {
+		u.Host = strings.TrimSuffix(u.Host, ":"+port)
+	}
+
+	if u.Host != "" && u.Path == "" {
+		u.Path = "/"
+	}
+
+	if u.RawQuery != "" {
+		u.RawQuery = u.Query().Encode()
+	}
+
+	return u.String()
+}
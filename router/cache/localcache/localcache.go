@@ -3,7 +3,6 @@ package localcache
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"net/http"
 	"net/url"
 
@@ -11,10 +10,17 @@ import (
 	"github.com/gu-io/gu/router/cache"
 )
 
-// API defines a structure which implements the cache.Cache interface.
+// entry defines a response kept by the cache with the key it was added with.
+type entry struct {
+	key  string
+	pair cache.WebPair
+}
+
+// API defines a structure which implements the cache.Cache interface, keeping
+// responses indexed by their cache.Key.
 type API struct {
-	name  string
-	pairs []cache.WebPair
+	name    string
+	entries []entry
 }
 
 // New returns a new instance of the API struct.
@@ -24,7 +30,9 @@ func New(name string) *API {
 
 // String returns a json version of the internal array of pairs.
 func (a *API) String() string {
-	jsx, err := json.Marshal(a.pairs)
+	pairs, _ := a.All()
+
+	jsx, err := json.Marshal(pairs)
 	if err != nil {
 		return ""
 	}
@@ -34,42 +42,33 @@ func (a *API) String() string {
 
 // Empty deletes all giving requests from the underline cache.
 func (a *API) Empty() error {
-	a.pairs = nil
+	a.entries = nil
 	return nil
 }
 
 // All returns all the pairs of requests which have been added into the cache in
 func (a *API) All() ([]cache.WebPair, error) {
-	return a.pairs[0:], nil
+	pairs := make([]cache.WebPair, len(a.entries))
+	for index, item := range a.entries {
+		pairs[index] = item.pair
+	}
+
+	return pairs, nil
 }
 
 // DeleteRequest calls the underline cache.Cache.Delete.
 func (a *API) DeleteRequest(w cache.Request) error {
-	for index, pair := range a.pairs {
-		if pair.Request.URL == w.URL {
-			a.pairs = append(a.pairs[0:index], a.pairs[index+1:]...)
-			return nil
-		}
-	}
-
-	return errors.New("Request not found")
+	return a.deleteKey(w.Key().String())
 }
 
 // Delete removes the giving path from the underline cache if found.
 func (a *API) Delete(path string) error {
-	return a.DeleteRequest(cache.Request{
-		Path: path,
-	})
+	return a.deleteKey(cache.PathKey(path).String())
 }
 
 // AddData adds the giving data object into the cache.
 func (a *API) AddData(req string, res []byte) error {
-	a.pairs = append(a.pairs, cache.WebPair{
-		Request:  cache.Request{Path: req, Method: "GET"},
-		Response: cache.Response{Method: "GET", Body: *bytes.NewBuffer(res)},
-	})
-
-	return nil
+	return a.PutPath(req, cache.Response{Method: "GET", Body: *bytes.NewBuffer(res)})
 }
 
 // Add adds the giving response object into the cache.
@@ -79,7 +78,7 @@ func (a *API) Add(req string, res *http.Response) error {
 		reqs = &cache.Request{Path: req, Method: "GET"}
 	}
 
-	a.pairs = append(a.pairs, cache.WebPair{
+	a.put(cache.PathKey(req).String(), cache.WebPair{
 		Request:  *reqs,
 		Response: *resp,
 	})
@@ -93,33 +92,25 @@ func (a *API) Serve(w http.ResponseWriter, r *http.Request) error {
 	// 1. Attempt to get full URI
 	// 2. Attempt to get only path
 
-	res, _, err := a.Get(r.URL.String())
+	_, res, err := a.Get(r.URL.String())
 	if err != nil {
-		res, _, err = a.Get(r.URL.Path)
+		_, res, err = a.Get(r.URL.Path)
 		if err != nil {
 			return err
 		}
 	}
 
-	if res.Body.Len() == 0 {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
-	}
-
-	w.WriteHeader(http.StatusOK)
-	w.Write(res.Body.Bytes())
+	cache.ServeResponse(w, res)
 	return nil
 }
 
 // Put calls the internal caches.Cache.Put function matching against the
 func (a *API) Put(req cache.Request, res cache.Response) error {
-	a.pairs = append(a.pairs, cache.WebPair{
+	a.put(req.Key().String(), cache.WebPair{
 		Request:  req,
 		Response: res,
 	})
 
-	a.sync()
-
 	return nil
 }
 
@@ -127,43 +118,69 @@ func (a *API) Put(req cache.Request, res cache.Response) error {
 func (a *API) PutPath(path string, res cache.Response) error {
 	var req cache.Request
 	req.Path = path
+	req.Method = "GET"
 
 	uri, _ := url.Parse(path)
 	req.URL = uri
 
-	a.pairs = append(a.pairs, cache.WebPair{
-		Request:  req,
-		Response: res,
-	})
-
-	a.sync()
-
-	return nil
+	return a.Put(req, res)
 }
 
 // GetRequest calls CacheAPI.Match and passing in a default MatchAttr value.
 func (a *API) GetRequest(w cache.Request) (cache.Response, error) {
-	for _, pair := range a.pairs {
-		if pair.Request.Path == w.Path {
-			return pair.Response, nil
-		}
+	if index := a.find(w.Key().String()); index != -1 {
+		return a.entries[index].pair.Response, nil
 	}
 
-	return cache.Response{}, errors.New("Request not found")
+	return cache.Response{}, cache.ErrNotFound
 }
 
 // Get calls CacheAPI.MatchPath and passing in a default MatchAttr value.
 func (a *API) Get(path string) (cache.Request, cache.Response, error) {
-	for _, pair := range a.pairs {
-		if pair.Request.Path == path {
-			return pair.Request, pair.Response, nil
-		}
+	if index := a.find(cache.PathKey(path).String()); index != -1 {
+		pair := a.entries[index].pair
+		return pair.Request, pair.Response, nil
 	}
 
 	return cache.Request{
 		Path:   path,
 		Method: "GET",
-	}, cache.Response{}, errors.New("Request not found")
+	}, cache.Response{}, cache.ErrNotFound
+}
+
+// find returns the index of the entry added with the key, or -1 if not found.
+func (a *API) find(key string) int {
+	for index, item := range a.entries {
+		if item.key == key {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// put adds the pair for the key, replacing any pair for the key.
+func (a *API) put(key string, pair cache.WebPair) {
+	if index := a.find(key); index != -1 {
+		a.entries[index].pair = pair
+	} else {
+		a.entries = append(a.entries, entry{key: key, pair: pair})
+	}
+
+	a.sync()
+}
+
+// deleteKey removes the pair added with the key.
+func (a *API) deleteKey(key string) error {
+	index := a.find(key)
+	if index == -1 {
+		return cache.ErrNotFound
+	}
+
+	a.entries = append(a.entries[:index], a.entries[index+1:]...)
+	a.sync()
+
+	return nil
 }
 
 // init intializes the cache and its dependencies.
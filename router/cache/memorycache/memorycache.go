@@ -2,28 +2,95 @@ package memorycache
 
 import (
 	"bytes"
+	"container/list"
 	"encoding/json"
-	"errors"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 
 	"github.com/gu-io/gu/router/cache"
 )
 
-// API defines a structure which implements the cache.Cache interface.
+// Option defines a function type used to configure a API.
+type Option func(*API)
+
+// MaxEntries sets the maximum number of responses kept by the cache, the least
+// recently used responses are evicted once exceeded.
+func MaxEntries(max int) Option {
+	return func(a *API) {
+		a.maxEntries = max
+	}
+}
+
+// MaxSize sets the maximum total size in bytes of the bodies of the responses
+// kept by the cache, the least recently used responses are evicted once
+// exceeded.
+func MaxSize(max int64) Option {
+	return func(a *API) {
+		a.maxSize = max
+	}
+}
+
+// TTL sets the duration responses are kept by the cache after being added.
+func TTL(ttl time.Duration) Option {
+	return func(a *API) {
+		a.ttl = ttl
+	}
+}
+
+// Clock sets the function which returns the current time used for the TTL of
+// responses, it defaults to time.Now.
+func Clock(now func() time.Time) Option {
+	return func(a *API) {
+		a.now = now
+	}
+}
+
+// entry defines a response kept by the cache.
+type entry struct {
+	key     string
+	pair    cache.WebPair
+	size    int64
+	expires time.Time
+}
+
+// API defines a structure which implements the cache.Cache interface, keeping
+// responses in memory indexed by their cache.Key.
 type API struct {
-	name  string
-	pairs []cache.WebPair
+	name       string
+	maxEntries int
+	maxSize    int64
+	ttl        time.Duration
+	now        func() time.Time
+
+	ml      sync.Mutex
+	size    int64
+	lru     *list.List
+	entries map[string]*list.Element
 }
 
 // New returns a new instance of the API struct.
-func New(name string) *API {
-	return &API{name: name}
+func New(name string, options ...Option) *API {
+	a := &API{
+		name:    name,
+		now:     time.Now,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+
+	for _, option := range options {
+		option(a)
+	}
+
+	return a
 }
 
 // String returns a json version of the internal array of pairs.
 func (a *API) String() string {
-	jsx, err := json.Marshal(a.pairs)
+	pairs, _ := a.All()
+
+	jsx, err := json.Marshal(pairs)
 	if err != nil {
 		return ""
 	}
@@ -31,54 +98,84 @@ func (a *API) String() string {
 	return string(jsx)
 }
 
+// Len returns the total number of responses in the cache.
+func (a *API) Len() int {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	return a.lru.Len()
+}
+
+// Size returns the total size in bytes of the bodies of the responses in the
+// cache.
+func (a *API) Size() int64 {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	return a.size
+}
+
 // Empty deletes all giving requests from the underline cache.
 func (a *API) Empty() error {
-	a.pairs = nil
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	a.size = 0
+	a.lru.Init()
+	a.entries = make(map[string]*list.Element)
+
 	return nil
 }
 
-// All returns all the pairs of requests which have been added into the cache in
+// All returns all the pairs of requests which have been added into the cache,
+// from the most recently used.
 func (a *API) All() ([]cache.WebPair, error) {
-	return a.pairs[0:], nil
-}
+	a.ml.Lock()
+	defer a.ml.Unlock()
 
-// DeleteRequest removes the underline request from the cache.
-func (a *API) DeleteRequest(w cache.Request) error {
-	for index, pair := range a.pairs {
-		if pair.Request.URL == w.URL {
-			a.pairs = append(a.pairs[0:index], a.pairs[index+1:]...)
-			return nil
+	now := a.now()
+
+	var pairs []cache.WebPair
+	for elem := a.lru.Front(); elem != nil; {
+		next := elem.Next()
+
+		item := elem.Value.(*entry)
+		if a.expired(item, now) {
+			a.remove(elem)
+		} else {
+			pairs = append(pairs, item.pair)
 		}
+
+		elem = next
 	}
 
-	return errors.New("Request not found")
+	return pairs, nil
+}
+
+// DeleteRequest removes the underline request from the cache.
+func (a *API) DeleteRequest(w cache.Request) error {
+	return a.deleteKey(w.Key().String())
 }
 
 // Delete removes the giving path from the underline cache if found.
 func (a *API) Delete(path string) error {
-	return a.DeleteRequest(cache.Request{
-		Path: path,
-	})
+	return a.deleteKey(cache.PathKey(path).String())
 }
 
 // AddData adds the giving data object into the cache.
 func (a *API) AddData(req string, res []byte) error {
-	a.pairs = append(a.pairs, cache.WebPair{
-		Request:  cache.Request{Path: req, Method: "GET"},
-		Response: cache.Response{Method: "GET", Body: *bytes.NewBuffer(res)},
-	})
-
-	return nil
+	return a.PutPath(req, cache.Response{Method: "GET", Body: *bytes.NewBuffer(res)})
 }
 
-// Add adds the giving response object into the cache.
+// Add adds the giving response object into the cache, replacing any response
+// for the path.
 func (a *API) Add(req string, res *http.Response) error {
 	resp, reqs := cache.HTTPResponseToResponse(res)
 	if reqs == nil {
 		reqs = &cache.Request{Path: req, Method: "GET"}
 	}
 
-	a.pairs = append(a.pairs, cache.WebPair{
+	a.put(cache.PathKey(req).String(), cache.WebPair{
 		Request:  *reqs,
 		Response: *resp,
 	})
@@ -92,27 +189,22 @@ func (a *API) Serve(w http.ResponseWriter, r *http.Request) error {
 	// 1. Attempt to get full URI
 	// 2. Attempt to get only path
 
-	res, _, err := a.Get(r.URL.String())
+	_, res, err := a.Get(r.URL.String())
 	if err != nil {
-		res, _, err = a.Get(r.URL.Path)
+		_, res, err = a.Get(r.URL.Path)
 		if err != nil {
 			return err
 		}
 	}
 
-	if res.Body.Len() == 0 {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
-	}
-
-	w.WriteHeader(http.StatusOK)
-	w.Write(res.Body.Bytes())
+	cache.ServeResponse(w, res)
 	return nil
 }
 
-// Put calls the internal caches.Cache.Put function matching against the
+// Put adds the response into the cache for the request, replacing any response
+// for the request.
 func (a *API) Put(req cache.Request, res cache.Response) error {
-	a.pairs = append(a.pairs, cache.WebPair{
+	a.put(req.Key().String(), cache.WebPair{
 		Request:  req,
 		Response: res,
 	})
@@ -120,43 +212,121 @@ func (a *API) Put(req cache.Request, res cache.Response) error {
 	return nil
 }
 
-// PutPath calls the internal caches.Cache.Put function matching against the
+// PutPath adds the response into the cache for the path, replacing any
+// response for the path.
 func (a *API) PutPath(path string, res cache.Response) error {
 	var req cache.Request
 	req.Path = path
+	req.Method = "GET"
 
 	uri, _ := url.Parse(path)
 	req.URL = uri
 
-	a.pairs = append(a.pairs, cache.WebPair{
-		Request:  req,
-		Response: res,
-	})
-
-	return nil
+	return a.Put(req, res)
 }
 
-// GetRequest calls CacheAPI.Match and passing in a default MatchAttr value.
+// GetRequest returns the response for the request.
 func (a *API) GetRequest(w cache.Request) (cache.Response, error) {
-	for _, pair := range a.pairs {
-		if pair.Request.Path == w.Path {
-			return pair.Response, nil
-		}
+	pair, err := a.getKey(w.Key().String())
+	if err != nil {
+		return cache.Response{}, err
 	}
 
-	return cache.Response{}, errors.New("Request not found")
+	return pair.Response, nil
 }
 
-// Get calls CacheAPI.MatchPath and passing in a default MatchAttr value.
+// Get returns the request and response for the path.
 func (a *API) Get(path string) (cache.Request, cache.Response, error) {
-	for _, pair := range a.pairs {
-		if pair.Request.Path == path {
-			return pair.Request, pair.Response, nil
-		}
+	pair, err := a.getKey(cache.PathKey(path).String())
+	if err != nil {
+		return cache.Request{
+			Path:   path,
+			Method: "GET",
+		}, cache.Response{}, err
 	}
 
-	return cache.Request{
-		Path:   path,
-		Method: "GET",
-	}, cache.Response{}, errors.New("Request not found")
+	return pair.Request, pair.Response, nil
+}
+
+// getKey returns the pair for the key, marking it as recently used.
+func (a *API) getKey(key string) (cache.WebPair, error) {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	elem, ok := a.entries[key]
+	if !ok {
+		return cache.WebPair{}, cache.ErrNotFound
+	}
+
+	item := elem.Value.(*entry)
+	if a.expired(item, a.now()) {
+		a.remove(elem)
+		return cache.WebPair{}, cache.ErrNotFound
+	}
+
+	a.lru.MoveToFront(elem)
+
+	return item.pair, nil
+}
+
+// deleteKey removes the pair for the key.
+func (a *API) deleteKey(key string) error {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	elem, ok := a.entries[key]
+	if !ok {
+		return cache.ErrNotFound
+	}
+
+	a.remove(elem)
+	return nil
+}
+
+// put adds the pair for the key, replacing any pair for the key and evicting
+// the least recently used pairs beyond the limits of the cache. Pairs whose body
+// is larger than the maximum size of the cache are not added.
+func (a *API) put(key string, pair cache.WebPair) {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	if elem, ok := a.entries[key]; ok {
+		a.remove(elem)
+	}
+
+	item := &entry{
+		key:  key,
+		pair: pair,
+		size: int64(pair.Response.Body.Len()),
+	}
+
+	// Responses larger than the cache are never kept.
+	if a.maxSize > 0 && item.size > a.maxSize {
+		return
+	}
+
+	if a.ttl > 0 {
+		item.expires = a.now().Add(a.ttl)
+	}
+
+	a.entries[key] = a.lru.PushFront(item)
+	a.size += item.size
+
+	for (a.maxEntries > 0 && a.lru.Len() > a.maxEntries) || (a.maxSize > 0 && a.size > a.maxSize) {
+		a.remove(a.lru.Back())
+	}
+}
+
+// remove removes the element from the cache.
+func (a *API) remove(elem *list.Element) {
+	item := elem.Value.(*entry)
+
+	a.lru.Remove(elem)
+	delete(a.entries, item.key)
+	a.size -= item.size
+}
+
+// expired returns true/false if the entry has outlived the TTL of the cache.
+func (a *API) expired(item *entry, now time.Time) bool {
+	return !item.expires.IsZero() && !now.Before(item.expires)
 }
@@ -0,0 +1,92 @@
+package memorycache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/router/cache"
+	"github.com/gu-io/gu/router/cache/cachetest"
+	"github.com/gu-io/gu/router/cache/memorycache"
+	"github.com/influx6/faux/tests"
+)
+
+func TestConformance(t *testing.T) {
+	cachetest.Conformance(t, func() cache.Cache {
+		return memorycache.New("conformance")
+	})
+}
+
+func TestMaxEntries(t *testing.T) {
+	c := memorycache.New("lru", memorycache.MaxEntries(2))
+
+	c.AddData("/first", []byte("first"))
+	c.AddData("/second", []byte("second"))
+
+	// Use the first response, leaving the second as least recently used.
+	c.Get("/first")
+	c.AddData("/third", []byte("third"))
+
+	if _, _, err := c.Get("/second"); err != cache.ErrNotFound {
+		tests.Failed("Should have evicted least recently used response: %+q", err)
+	}
+	tests.Passed("Should have evicted least recently used response")
+
+	if _, _, err := c.Get("/first"); err != nil {
+		tests.Failed("Should have kept recently used response: %+q", err)
+	}
+	tests.Passed("Should have kept recently used response")
+
+	if c.Len() != 2 {
+		tests.Failed("Should have kept maximum entries: %d", c.Len())
+	}
+	tests.Passed("Should have kept maximum entries")
+}
+
+func TestMaxSize(t *testing.T) {
+	c := memorycache.New("size", memorycache.MaxSize(10))
+
+	c.AddData("/first", []byte("12345"))
+	c.AddData("/second", []byte("12345"))
+	c.AddData("/third", []byte("123"))
+
+	if _, _, err := c.Get("/first"); err != cache.ErrNotFound {
+		tests.Failed("Should have evicted response beyond maximum size: %+q", err)
+	}
+	tests.Passed("Should have evicted response beyond maximum size")
+
+	if c.Size() != 8 {
+		tests.Failed("Should have tracked size of responses: %d", c.Size())
+	}
+	tests.Passed("Should have tracked size of responses")
+
+	c.AddData("/large", []byte("12345678901"))
+
+	if _, _, err := c.Get("/large"); err != cache.ErrNotFound || c.Len() != 2 {
+		tests.Failed("Should have not added response larger than cache: %+q", err)
+	}
+	tests.Passed("Should have not added response larger than cache")
+}
+
+func TestTTL(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	c := memorycache.New("ttl", memorycache.TTL(time.Minute), memorycache.Clock(func() time.Time {
+		return now
+	}))
+
+	c.AddData("/first", []byte("first"))
+
+	now = now.Add(30 * time.Second)
+
+	if _, _, err := c.Get("/first"); err != nil {
+		tests.Failed("Should have kept response within ttl: %+q", err)
+	}
+	tests.Passed("Should have kept response within ttl")
+
+	now = now.Add(30 * time.Second)
+
+	if _, _, err := c.Get("/first"); err != cache.ErrNotFound || c.Len() != 0 {
+		tests.Failed("Should have evicted response after ttl: %+q", err)
+	}
+	tests.Passed("Should have evicted response after ttl")
+}
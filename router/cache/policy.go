@@ -0,0 +1,602 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Strategy defines how a Policy uses the cache and the handler (the network) to
+// serve a request.
+type Strategy int
+
+const (
+	// DefaultStrategy uses the strategy of the Policy, which is CacheFirst
+	// unless set otherwise.
+	DefaultStrategy Strategy = iota
+
+	// CacheFirst serves fresh responses from the cache, revalidating stale
+	// responses through the handler before serving them.
+	CacheFirst
+
+	// NetworkFirst serves every request through the handler, revalidating the
+	// cached response if any, and serves the cached response when the handler
+	// fails with a server error.
+	NetworkFirst
+
+	// StaleWhileRevalidate serves cached responses even when stale, revalidating
+	// stale responses through the handler in the background. Responses which
+	// must be revalidated are served like CacheFirst.
+	StaleWhileRevalidate
+)
+
+// cacheableStatus defines the response status codes which are cacheable by
+// default, as defined by RFC 7231.
+var cacheableStatus = map[int]bool{
+	http.StatusOK:                   true,
+	http.StatusNonAuthoritativeInfo: true,
+	http.StatusNoContent:            true,
+	http.StatusMultipleChoices:      true,
+	http.StatusMovedPermanently:     true,
+	http.StatusNotFound:             true,
+	http.StatusMethodNotAllowed:     true,
+	http.StatusGone:                 true,
+	http.StatusRequestURITooLong:    true,
+	http.StatusNotImplemented:       true,
+}
+
+// Policy defines a caching layer which stores the responses of a handler into a
+// Cache according to the HTTP caching rules (RFC 7234), honouring the
+// Cache-Control, Expires, ETag, Last-Modified and Vary headers of responses and
+// requests. Stale responses are revalidated through the handler with
+// If-None-Match and If-Modified-Since requests.
+//
+// Responses marked private, or given to requests with an Authorization header
+// without being marked public or s-maxage, are not stored.
+//
+// Responses are stored by the url of their request or the key given to
+// ServeKey, with a single variant kept for each key. Responses added into the Cache directly (e.g through AddData)
+// have no Date header and are considered fresh till removed.
+type Policy struct {
+	cache    Cache
+	strategy Strategy
+
+	// Now returns the current time used to compute the age of responses, it
+	// defaults to time.Now.
+	Now func() time.Time
+
+	ml sync.Mutex
+	wg sync.WaitGroup
+}
+
+// NewPolicy returns a new Policy which stores responses into the cache, serving
+// requests with the strategy.
+func NewPolicy(c Cache, strategy Strategy) *Policy {
+	if strategy == DefaultStrategy {
+		strategy = CacheFirst
+	}
+
+	return &Policy{
+		cache:    c,
+		strategy: strategy,
+		Now:      time.Now,
+	}
+}
+
+// Wait blocks till all background revalidations are done.
+func (p *Policy) Wait() {
+	p.wg.Wait()
+}
+
+// Serve serves the request with the strategy of the Policy.
+func (p *Policy) Serve(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	p.ServeWith(w, r, DefaultStrategy, next)
+}
+
+// ServeWith serves the request with the giving strategy, using the cache and
+// the next handler.
+func (p *Policy) ServeWith(w http.ResponseWriter, r *http.Request, strategy Strategy, next http.Handler) {
+	p.ServeKey(w, r, r.URL.String(), strategy, next)
+}
+
+// ServeKey serves the request like ServeWith, storing it's response under the
+// key instead of the url of the request, e.g the url of the request before a
+// Mux stripped it's namespace from it.
+func (p *Policy) ServeKey(w http.ResponseWriter, r *http.Request, key string, strategy Strategy, next http.Handler) {
+	if strategy == DefaultStrategy {
+		strategy = p.strategy
+	}
+
+	reqControl := parseCacheControl(r.Header["Cache-Control"])
+
+	if r.Method != "GET" {
+		res := p.fetch(r, next)
+
+		// Unsafe methods which succeed invalidate the cached response.
+		if r.Method != "HEAD" && r.Method != "OPTIONS" && res.StatusCode < 400 {
+			p.remove(key)
+		}
+
+		writeResponse(w, res.StatusCode, res.Header, res.body)
+		return
+	}
+
+	if reqControl.has("no-store") {
+		res := p.fetch(r, next)
+		writeResponse(w, res.StatusCode, res.Header, res.body)
+		return
+	}
+
+	stored, found := p.lookup(r, key)
+	if !found {
+		p.serveNetwork(w, r, key, next, nil)
+		return
+	}
+
+	fresh := stored.fresh(p.Now(), reqControl)
+
+	switch strategy {
+	case NetworkFirst:
+		p.serveNetwork(w, r, key, next, stored)
+		return
+	case StaleWhileRevalidate:
+		if fresh || stored.mustRevalidate() || reqControl.has("no-cache") {
+			break
+		}
+
+		p.serveStored(w, r, stored)
+
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			// The revalidation outlives the request, so it is detached
+			// from the cancellation of the request.
+			p.revalidate(r.WithContext(context.Background()), key, next, stored)
+		}()
+
+		return
+	}
+
+	if fresh {
+		p.serveStored(w, r, stored)
+		return
+	}
+
+	p.serveNetwork(w, r, key, next, stored)
+}
+
+// serveNetwork serves the request through the handler, revalidating the stored
+// response if any, storing the response under the key if cacheable.
+func (p *Policy) serveNetwork(w http.ResponseWriter, r *http.Request, key string, next http.Handler, stored *entry) {
+	if stored == nil {
+		res := p.fetch(r, next)
+		p.store(r, key, res)
+		writeResponse(w, res.StatusCode, res.Header, res.body)
+		return
+	}
+
+	res, revalidated := p.revalidate(r, key, next, stored)
+	if revalidated != nil {
+		p.serveStored(w, r, revalidated)
+		return
+	}
+
+	// Serve the stale response when the handler fails.
+	if res.StatusCode >= 500 && !stored.mustRevalidate() {
+		p.serveStored(w, r, stored)
+		return
+	}
+
+	writeResponse(w, res.StatusCode, res.Header, res.body)
+}
+
+// revalidate requests the stored response through the handler conditionally,
+// returning the updated stored response if the handler responds it is not
+// modified, else storing the response received under the key.
+func (p *Policy) revalidate(r *http.Request, key string, next http.Handler, stored *entry) (*response, *entry) {
+	req := cloneRequest(r)
+	req.Header.Del("If-None-Match")
+	req.Header.Del("If-Modified-Since")
+
+	if etag := stored.header.Get("ETag"); etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	if modified := stored.header.Get("Last-Modified"); modified != "" {
+		req.Header.Set("If-Modified-Since", modified)
+	}
+
+	res := p.fetch(req, next)
+
+	if res.StatusCode != http.StatusNotModified {
+		if res.StatusCode < 500 {
+			p.store(r, key, res)
+		}
+
+		return res, nil
+	}
+
+	// Update the stored response with the headers of the revalidation.
+	for _, name := range []string{"Cache-Control", "Date", "ETag", "Expires", "Last-Modified", "Vary"} {
+		if value := res.Header.Get(name); value != "" {
+			stored.header.Set(name, value)
+		}
+	}
+
+	if res.Header.Get("Date") == "" {
+		stored.header.Set("Date", p.Now().UTC().Format(http.TimeFormat))
+	}
+
+	p.store(r, key, &response{
+		StatusCode: stored.status,
+		Header:     stored.header,
+		body:       stored.body,
+	})
+
+	return res, stored
+}
+
+// serveStored writes the stored response, responding not modified if the
+// request is conditional and matches it.
+func (p *Policy) serveStored(w http.ResponseWriter, r *http.Request, stored *entry) {
+	header := cloneHeader(stored.header)
+
+	if stored.date != nil {
+		header.Set("Age", strconv.Itoa(int(stored.age(p.Now()).Seconds())))
+	}
+
+	if notModified(r, stored.header) {
+		writeResponse(w, http.StatusNotModified, header, nil)
+		return
+	}
+
+	writeResponse(w, stored.status, header, stored.body)
+}
+
+// fetch serves the request through the handler, returning the response.
+func (p *Policy) fetch(r *http.Request, next http.Handler) *response {
+	recorder := httptest.NewRecorder()
+	next.ServeHTTP(recorder, r)
+
+	res := recorder.Result()
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	return &response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		body:       body,
+	}
+}
+
+// store adds the response for the request into the cache under the key if it
+// is cacheable.
+func (p *Policy) store(r *http.Request, key string, res *response) {
+	// Responses of cancelled requests may be incomplete.
+	if r.Context().Err() != nil {
+		return
+	}
+
+	resControl := parseCacheControl(res.Header["Cache-Control"])
+
+	if !cacheableStatus[res.StatusCode] || resControl.has("no-store") || resControl.has("private") || res.Header.Get("Vary") == "*" {
+		return
+	}
+
+	// Responses of authorized requests are only shared if explicitly allowed.
+	if r.Header.Get("Authorization") != "" && !resControl.has("public") && !resControl.has("s-maxage") {
+		return
+	}
+
+	_, maxAge := resControl.get("max-age")
+	explicit := maxAge || res.Header.Get("Expires") != ""
+	validated := res.Header.Get("ETag") != "" || res.Header.Get("Last-Modified") != ""
+
+	if !explicit && !validated {
+		return
+	}
+
+	header := cloneHeader(res.Header)
+	if header.Get("Date") == "" {
+		header.Set("Date", p.Now().UTC().Format(http.TimeFormat))
+	}
+
+	// The values of the Cache-Control header and the headers the response varies
+	// by are stored joined, as caches keep a single value for each header.
+	if control, ok := header["Cache-Control"]; ok {
+		header.Set("Cache-Control", joinValues(control))
+	}
+
+	request := cloneRequest(r)
+
+	if vary, ok := header["Vary"]; ok {
+		header.Set("Vary", joinValues(vary))
+
+		for _, name := range splitValues(vary) {
+			name = http.CanonicalHeaderKey(name)
+			if values, ok := request.Header[name]; ok {
+				request.Header.Set(name, joinValues(values))
+			}
+		}
+	}
+
+	p.ml.Lock()
+	defer p.ml.Unlock()
+
+	p.cache.Delete(key)
+	p.cache.Add(key, &http.Response{
+		StatusCode: res.StatusCode,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewReader(res.body)),
+		Request:    request,
+	})
+}
+
+// remove deletes the response stored for the key.
+func (p *Policy) remove(key string) {
+	p.ml.Lock()
+	defer p.ml.Unlock()
+
+	p.cache.Delete(key)
+}
+
+// lookup returns the response stored under the key for the request, if the
+// request matches the headers the response varies by.
+func (p *Policy) lookup(r *http.Request, key string) (*entry, bool) {
+	p.ml.Lock()
+	req, res, err := p.cache.Get(key)
+	p.ml.Unlock()
+
+	if err != nil {
+		return nil, false
+	}
+
+	header := make(http.Header)
+	for name, value := range res.Headers {
+		header.Set(name, value)
+	}
+
+	for _, name := range splitValues(header["Vary"]) {
+		name = http.CanonicalHeaderKey(name)
+		if joinValues(r.Header[name]) != req.Headers[name] {
+			return nil, false
+		}
+	}
+
+	stored := &entry{
+		status: res.Status,
+		header: header,
+		body:   res.Body.Bytes(),
+	}
+
+	if stored.status == 0 {
+		stored.status = http.StatusOK
+	}
+
+	if date, err := http.ParseTime(header.Get("Date")); err == nil {
+		stored.date = &date
+	}
+
+	return stored, true
+}
+
+//==============================================================================
+
+// response defines a response received from the handler.
+type response struct {
+	StatusCode int
+	Header     http.Header
+	body       []byte
+}
+
+// entry defines a response stored in the cache.
+type entry struct {
+	status int
+	header http.Header
+	body   []byte
+	date   *time.Time
+}
+
+// age returns the age of the stored response.
+func (e *entry) age(now time.Time) time.Duration {
+	age := now.Sub(*e.date)
+
+	if seconds, err := strconv.Atoi(e.header.Get("Age")); err == nil {
+		age += time.Duration(seconds) * time.Second
+	}
+
+	if age < 0 {
+		return 0
+	}
+
+	return age
+}
+
+// lifetime returns the duration the stored response is fresh for.
+func (e *entry) lifetime() time.Duration {
+	control := parseCacheControl(e.header["Cache-Control"])
+
+	if value, ok := control.get("max-age"); ok {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+
+		return 0
+	}
+
+	if expires := e.header.Get("Expires"); expires != "" {
+		at, err := http.ParseTime(expires)
+		if err != nil {
+			return 0
+		}
+
+		return at.Sub(*e.date)
+	}
+
+	// Heuristic freshness of a tenth of the time since last modified.
+	if modified, err := http.ParseTime(e.header.Get("Last-Modified")); err == nil && modified.Before(*e.date) {
+		return e.date.Sub(modified) / 10
+	}
+
+	return 0
+}
+
+// fresh returns true/false if the stored response can be served without
+// revalidation for a request with the giving cache control.
+func (e *entry) fresh(now time.Time, reqControl cacheControl) bool {
+	if reqControl.has("no-cache") {
+		return false
+	}
+
+	control := parseCacheControl(e.header["Cache-Control"])
+	if control.has("no-cache") {
+		return false
+	}
+
+	// Responses added directly into the cache are always fresh.
+	if e.date == nil {
+		return true
+	}
+
+	age := e.age(now)
+
+	if value, ok := reqControl.get("max-age"); ok {
+		if seconds, err := strconv.Atoi(value); err == nil && age > time.Duration(seconds)*time.Second {
+			return false
+		}
+	}
+
+	return age < e.lifetime()
+}
+
+// mustRevalidate returns true/false if the stored response must not be served
+// when stale.
+func (e *entry) mustRevalidate() bool {
+	control := parseCacheControl(e.header["Cache-Control"])
+	return control.has("must-revalidate") || control.has("no-cache")
+}
+
+//==============================================================================
+
+// cacheControl defines the directives of a Cache-Control header.
+type cacheControl map[string]string
+
+// parseCacheControl returns the directives of the values of a Cache-Control
+// header.
+func parseCacheControl(values []string) cacheControl {
+	control := make(cacheControl)
+
+	for _, directive := range splitValues(values) {
+		name, arg := directive, ""
+		if index := strings.Index(directive, "="); index != -1 {
+			name, arg = directive[:index], strings.Trim(directive[index+1:], `"`)
+		}
+
+		control[strings.ToLower(strings.TrimSpace(name))] = arg
+	}
+
+	return control
+}
+
+// has returns true/false if the directive is set.
+func (c cacheControl) has(name string) bool {
+	_, ok := c[name]
+	return ok
+}
+
+// get returns the argument of the directive and true/false if it is set.
+func (c cacheControl) get(name string) (string, bool) {
+	value, ok := c[name]
+	return value, ok
+}
+
+// notModified returns true/false if the conditional request matches the
+// response headers.
+func notModified(r *http.Request, header http.Header) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		etag := header.Get("ETag")
+		if etag == "" {
+			return false
+		}
+
+		for _, item := range strings.Split(match, ",") {
+			item = strings.TrimSpace(item)
+			if item == "*" || strings.TrimPrefix(item, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+
+	modified, err := http.ParseTime(header.Get("Last-Modified"))
+	if err != nil {
+		return false
+	}
+
+	return !modified.After(since)
+}
+
+// writeResponse writes the status, headers and body into the writer.
+func writeResponse(w http.ResponseWriter, status int, header http.Header, body []byte) {
+	for name, values := range header {
+		w.Header()[name] = values
+	}
+
+	w.WriteHeader(status)
+
+	if len(body) != 0 && status != http.StatusNotModified {
+		w.Write(body)
+	}
+}
+
+// cloneRequest returns a copy of the request with a copy of it's headers.
+func cloneRequest(r *http.Request) *http.Request {
+	req := new(http.Request)
+	*req = *r
+	req.Header = cloneHeader(r.Header)
+	return req
+}
+
+// splitValues returns the comma separated values of the values of a header.
+func splitValues(values []string) []string {
+	var split []string
+
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				split = append(split, item)
+			}
+		}
+	}
+
+	return split
+}
+
+// joinValues returns the values of a header as a single value, so equivalent
+// values given within one or many header lines are equal.
+func joinValues(values []string) string {
+	return strings.Join(splitValues(values), ", ")
+}
+
+// cloneHeader returns a copy of the header.
+func cloneHeader(header http.Header) http.Header {
+	cloned := make(http.Header, len(header))
+
+	for name, values := range header {
+		cloned[name] = append([]string(nil), values...)
+	}
+
+	return cloned
+}
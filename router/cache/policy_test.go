@@ -0,0 +1,322 @@
+package cache_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/router/cache"
+	"github.com/gu-io/gu/router/cache/memorycache"
+	"github.com/influx6/faux/tests"
+)
+
+// origin defines a handler which serves a versioned resource, answering
+// conditional requests for it's current version.
+type origin struct {
+	ml       sync.Mutex
+	version  int
+	requests int
+	control  string
+	vary     string
+	fail     bool
+}
+
+func (o *origin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	o.ml.Lock()
+	defer o.ml.Unlock()
+
+	o.requests++
+
+	if o.fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	etag := fmt.Sprintf(`"v%d"`, o.version)
+
+	if o.control != "" {
+		w.Header().Set("Cache-Control", o.control)
+	}
+
+	if o.vary != "" {
+		w.Header().Set("Vary", o.vary)
+	}
+
+	w.Header().Set("ETag", etag)
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "version %d %s", o.version, r.Header.Get("Accept-Language"))
+}
+
+func (o *origin) Requests() int {
+	o.ml.Lock()
+	defer o.ml.Unlock()
+	return o.requests
+}
+
+func (o *origin) Update(fn func(*origin)) {
+	o.ml.Lock()
+	defer o.ml.Unlock()
+	fn(o)
+}
+
+// clock defines a adjustable time source for a Policy.
+type clock struct {
+	ml  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.ml.Lock()
+	defer c.ml.Unlock()
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.ml.Lock()
+	defer c.ml.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newPolicy(strategy cache.Strategy) (*cache.Policy, *clock) {
+	clk := &clock{now: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	policy := cache.NewPolicy(memorycache.New("policy"), strategy)
+	policy.Now = clk.Now
+
+	return policy, clk
+}
+
+func serve(policy *cache.Policy, handler http.Handler, method string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "http://localhost/resource", nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	recorder := httptest.NewRecorder()
+	policy.Serve(recorder, req, handler)
+
+	return recorder
+}
+
+func TestPolicyCacheFirst(t *testing.T) {
+	policy, clk := newPolicy(cache.CacheFirst)
+	server := &origin{control: "max-age=60"}
+
+	serve(policy, server, "GET", nil)
+	res := serve(policy, server, "GET", nil)
+
+	if server.Requests() != 1 || res.Body.String() != "version 0 " {
+		tests.Failed("Should have served fresh response from cache: %d %q", server.Requests(), res.Body.String())
+	}
+	tests.Passed("Should have served fresh response from cache")
+
+	clk.Add(30 * time.Second)
+
+	if res = serve(policy, server, "GET", nil); res.Header().Get("Age") != "30" {
+		tests.Failed("Should have served age of cached response: %q", res.Header().Get("Age"))
+	}
+	tests.Passed("Should have served age of cached response")
+
+	clk.Add(31 * time.Second)
+
+	res = serve(policy, server, "GET", nil)
+	if server.Requests() != 2 || res.Code != http.StatusOK || res.Body.String() != "version 0 " {
+		tests.Failed("Should have revalidated stale response: %d %d %q", server.Requests(), res.Code, res.Body.String())
+	}
+	tests.Passed("Should have revalidated stale response")
+
+	serve(policy, server, "GET", nil)
+	if server.Requests() != 2 {
+		tests.Failed("Should have refreshed revalidated response: %d", server.Requests())
+	}
+	tests.Passed("Should have refreshed revalidated response")
+
+	clk.Add(61 * time.Second)
+	server.Update(func(o *origin) { o.version = 1 })
+
+	if res = serve(policy, server, "GET", nil); res.Body.String() != "version 1 " {
+		tests.Failed("Should have replaced modified response: %q", res.Body.String())
+	}
+	tests.Passed("Should have replaced modified response")
+
+	if res = serve(policy, server, "GET", map[string]string{"If-None-Match": `"v1"`}); res.Code != http.StatusNotModified || server.Requests() != 3 {
+		tests.Failed("Should have answered conditional request from cache: %d", res.Code)
+	}
+	tests.Passed("Should have answered conditional request from cache")
+
+	serve(policy, server, "GET", map[string]string{"Cache-Control": "no-cache"})
+	if server.Requests() != 4 {
+		tests.Failed("Should have revalidated request with no-cache: %d", server.Requests())
+	}
+	tests.Passed("Should have revalidated request with no-cache")
+
+	serve(policy, server, "POST", nil)
+	serve(policy, server, "GET", nil)
+	if server.Requests() != 6 {
+		tests.Failed("Should have invalidated response after POST: %d", server.Requests())
+	}
+	tests.Passed("Should have invalidated response after POST")
+}
+
+func TestPolicyNoStore(t *testing.T) {
+	policy, _ := newPolicy(cache.CacheFirst)
+	server := &origin{control: "no-store, max-age=60"}
+
+	serve(policy, server, "GET", nil)
+	serve(policy, server, "GET", nil)
+
+	if server.Requests() != 2 {
+		tests.Failed("Should have not stored no-store response: %d", server.Requests())
+	}
+	tests.Passed("Should have not stored no-store response")
+}
+
+func TestPolicyPrivate(t *testing.T) {
+	policy, _ := newPolicy(cache.CacheFirst)
+	server := &origin{control: "private, max-age=60"}
+
+	serve(policy, server, "GET", nil)
+	serve(policy, server, "GET", nil)
+
+	if server.Requests() != 2 {
+		tests.Failed("Should have not stored private response: %d", server.Requests())
+	}
+	tests.Passed("Should have not stored private response")
+
+	lines := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Cache-Control", "max-age=60")
+		w.Header().Add("Cache-Control", "private")
+		server.ServeHTTP(w, r)
+	})
+
+	serve(policy, lines, "GET", nil)
+	serve(policy, lines, "GET", nil)
+
+	if server.Requests() != 4 {
+		tests.Failed("Should have not stored response with private in any Cache-Control line: %d", server.Requests())
+	}
+	tests.Passed("Should have not stored response with private in any Cache-Control line")
+}
+
+func TestPolicyAuthorization(t *testing.T) {
+	policy, _ := newPolicy(cache.CacheFirst)
+	server := &origin{control: "max-age=60"}
+	auth := map[string]string{"Authorization": "Bearer token"}
+
+	serve(policy, server, "GET", auth)
+	serve(policy, server, "GET", auth)
+
+	if server.Requests() != 2 {
+		tests.Failed("Should have not stored response of authorized request: %d", server.Requests())
+	}
+	tests.Passed("Should have not stored response of authorized request")
+
+	server.Update(func(o *origin) { o.control = "public, max-age=60" })
+
+	serve(policy, server, "GET", auth)
+	serve(policy, server, "GET", auth)
+
+	if server.Requests() != 3 {
+		tests.Failed("Should have stored public response of authorized request: %d", server.Requests())
+	}
+	tests.Passed("Should have stored public response of authorized request")
+}
+
+func TestPolicyVary(t *testing.T) {
+	policy, _ := newPolicy(cache.CacheFirst)
+	server := &origin{control: "max-age=60", vary: "Accept-Language"}
+
+	serve(policy, server, "GET", map[string]string{"Accept-Language": "en"})
+	serve(policy, server, "GET", map[string]string{"Accept-Language": "en"})
+
+	if server.Requests() != 1 {
+		tests.Failed("Should have served matching variant from cache: %d", server.Requests())
+	}
+	tests.Passed("Should have served matching variant from cache")
+
+	res := serve(policy, server, "GET", map[string]string{"Accept-Language": "fr"})
+	if server.Requests() != 2 || res.Body.String() != "version 0 fr" {
+		tests.Failed("Should have not served other variant from cache: %d %q", server.Requests(), res.Body.String())
+	}
+	tests.Passed("Should have not served other variant from cache")
+}
+
+func TestPolicyVaryMultipleValues(t *testing.T) {
+	policy, _ := newPolicy(cache.CacheFirst)
+	server := &origin{control: "max-age=60", vary: "Accept-Encoding"}
+
+	request := func(values ...string) {
+		req := httptest.NewRequest("GET", "http://localhost/resource", nil)
+		req.Header["Accept-Encoding"] = values
+
+		policy.Serve(httptest.NewRecorder(), req, server)
+	}
+
+	request("gzip, br")
+	request("gzip", "br")
+	request("gzip,br")
+
+	if server.Requests() != 1 {
+		tests.Failed("Should have served variant of equivalent values from cache: %d", server.Requests())
+	}
+	tests.Passed("Should have served variant of equivalent values from cache")
+
+	request("gzip")
+	request("br, gzip")
+
+	if server.Requests() != 3 {
+		tests.Failed("Should have not served variant of other values from cache: %d", server.Requests())
+	}
+	tests.Passed("Should have not served variant of other values from cache")
+}
+
+func TestPolicyNetworkFirst(t *testing.T) {
+	policy, _ := newPolicy(cache.NetworkFirst)
+	server := &origin{control: "max-age=60"}
+
+	serve(policy, server, "GET", nil)
+	serve(policy, server, "GET", nil)
+
+	if server.Requests() != 2 {
+		tests.Failed("Should have requested fresh response through handler: %d", server.Requests())
+	}
+	tests.Passed("Should have requested fresh response through handler")
+
+	server.Update(func(o *origin) { o.fail = true })
+
+	if res := serve(policy, server, "GET", nil); res.Code != http.StatusOK || res.Body.String() != "version 0 " {
+		tests.Failed("Should have served cached response on failure: %d %q", res.Code, res.Body.String())
+	}
+	tests.Passed("Should have served cached response on failure")
+}
+
+func TestPolicyStaleWhileRevalidate(t *testing.T) {
+	policy, clk := newPolicy(cache.StaleWhileRevalidate)
+	server := &origin{control: "max-age=60"}
+
+	serve(policy, server, "GET", nil)
+
+	clk.Add(61 * time.Second)
+	server.Update(func(o *origin) { o.version = 1 })
+
+	if res := serve(policy, server, "GET", nil); res.Body.String() != "version 0 " {
+		tests.Failed("Should have served stale response: %q", res.Body.String())
+	}
+	tests.Passed("Should have served stale response")
+
+	policy.Wait()
+
+	if res := serve(policy, server, "GET", nil); res.Body.String() != "version 1 " || server.Requests() != 2 {
+		tests.Failed("Should have revalidated stale response in background: %d %q", server.Requests(), res.Body.String())
+	}
+	tests.Passed("Should have revalidated stale response in background")
+}
@@ -0,0 +1,175 @@
+package router
+
+import (
+	"strings"
+	"sync"
+)
+
+// Mode defines the part of urls which routes are resolved with.
+type Mode int
+
+const (
+	// HashMode defines that routes are resolved with the hash of urls, eg.
+	// "/#/users/12", which needs no support from the server.
+	HashMode Mode = iota
+
+	// PathMode defines that routes are resolved with the path of urls, eg.
+	// "/users/12", which are pushed with the HTML5 history api.
+	PathMode
+)
+
+// Event returns the PushEvent of the url for the mode. Urls without a hash are
+// taken as the route in HashMode, so "/users" resolves as "/#/users".
+func (m Mode) Event(url string) (PushEvent, error) {
+	if m == HashMode && !strings.Contains(url, "#") {
+		url = "/#" + url
+	}
+
+	return NewPushEvent(url, m == HashMode)
+}
+
+// URL returns the url of the event for the mode, with the query of the event.
+func (m Mode) URL(event PushEvent) string {
+	var query string
+	if len(event.Query) != 0 {
+		query = "?" + event.Query.Encode()
+	}
+
+	if m == HashMode {
+		hash := event.Hash
+		if hash == "/#" {
+			hash = "/"
+		}
+
+		return "#" + hash + query
+	}
+
+	return event.Path + query
+}
+
+// ScrollPosition defines the scroll position of a page.
+type ScrollPosition struct {
+	X float64
+	Y float64
+}
+
+// HistoryEntry defines a route visited within a History, with the scroll
+// position of the page when it was left.
+type HistoryEntry struct {
+	Event  PushEvent
+	Scroll ScrollPosition
+}
+
+// History defines a stack of visited routes with a current position, with the
+// semantics of the history of browsers, where pushing a route discards the
+// routes ahead of the current one.
+type History struct {
+	ml      sync.Mutex
+	index   int
+	entries []HistoryEntry
+}
+
+// NewHistory returns a new instance of History, with the event as it's current
+// route.
+func NewHistory(event PushEvent) *History {
+	return &History{
+		entries: []HistoryEntry{{Event: event}},
+	}
+}
+
+// Push adds the event after the current route, discarding the routes ahead of
+// it, and makes it the current route.
+func (h *History) Push(event PushEvent) {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	h.entries = append(h.entries[:h.index+1], HistoryEntry{Event: event})
+	h.index++
+}
+
+// Replace replaces the current route with the event.
+func (h *History) Replace(event PushEvent) {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	h.entries[h.index] = HistoryEntry{Event: event}
+}
+
+// Navigate pushes or replaces the current route with the event, as set by the
+// directive.
+func (h *History) Navigate(event PushEvent, directive PushDirectiveEvent) {
+	if directive.Replace {
+		h.Replace(event)
+		return
+	}
+
+	h.Push(event)
+}
+
+// Go moves the current route by delta, returning the new current route. It
+// returns false if there is no route at the position.
+func (h *History) Go(delta int) (HistoryEntry, bool) {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	index := h.index + delta
+	if delta == 0 || index < 0 || index >= len(h.entries) {
+		return HistoryEntry{}, false
+	}
+
+	h.index = index
+	return h.entries[index], true
+}
+
+// Back moves the current route to the previous route.
+func (h *History) Back() (HistoryEntry, bool) {
+	return h.Go(-1)
+}
+
+// Forward moves the current route to the next route.
+func (h *History) Forward() (HistoryEntry, bool) {
+	return h.Go(1)
+}
+
+// Current returns the current route.
+func (h *History) Current() HistoryEntry {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	return h.entries[h.index]
+}
+
+// Index returns the position of the current route.
+func (h *History) Index() int {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	return h.index
+}
+
+// Len returns the total routes of the history.
+func (h *History) Len() int {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	return len(h.entries)
+}
+
+// Entries returns a copy of the routes of the history.
+func (h *History) Entries() []HistoryEntry {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	entries := make([]HistoryEntry, len(h.entries))
+	copy(entries, h.entries)
+	return entries
+}
+
+// SaveScroll records the scroll position of the page on the current route,
+// which is returned when the route is visited again through Go.
+func (h *History) SaveScroll(position ScrollPosition) {
+	h.ml.Lock()
+	defer h.ml.Unlock()
+
+	h.entries[h.index].Scroll = position
+}
@@ -0,0 +1,69 @@
+package router_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/router"
+	"github.com/influx6/faux/tests"
+)
+
+func TestHistory(t *testing.T) {
+	event := func(to string) router.PushEvent {
+		pe, err := router.PathMode.Event(to)
+		if err != nil {
+			tests.Failed("Should have created event for %q: %+q", to, err)
+		}
+
+		return pe
+	}
+
+	history := router.NewHistory(event("/"))
+	history.Push(event("/users"))
+	history.Push(event("/users/12"))
+
+	history.SaveScroll(router.ScrollPosition{Y: 300})
+
+	if entry, ok := history.Back(); !ok || entry.Event.Path != "/users" || entry.Scroll.Y != 0 {
+		tests.Failed("Should have moved back: %#v", entry)
+	}
+	tests.Passed("Should have moved back")
+
+	if entry, ok := history.Forward(); !ok || entry.Event.Path != "/users/12" || entry.Scroll.Y != 300 {
+		tests.Failed("Should have moved forward with saved scroll: %#v", entry)
+	}
+	tests.Passed("Should have moved forward with saved scroll")
+
+	if _, ok := history.Forward(); ok {
+		tests.Failed("Should have not moved past last route")
+	}
+	tests.Passed("Should have not moved past last route")
+
+	history.Go(-2)
+	history.Navigate(event("/about"), router.PushDirectiveEvent{To: "/about"})
+
+	if history.Len() != 2 || history.Current().Event.Path != "/about" {
+		tests.Failed("Should have discarded routes ahead of pushed route: %d", history.Len())
+	}
+	tests.Passed("Should have discarded routes ahead of pushed route")
+
+	history.Navigate(event("/login"), router.PushDirectiveEvent{To: "/login", Replace: true})
+
+	if history.Len() != 2 || history.Index() != 1 || history.Current().Event.Path != "/login" {
+		tests.Failed("Should have replaced current route: %d", history.Len())
+	}
+	tests.Passed("Should have replaced current route")
+}
+
+func TestModes(t *testing.T) {
+	pe, _ := router.HashMode.Event("/users?page=2")
+	if pe.Rem != "/users" || pe.Query.Get("page") != "2" || router.HashMode.URL(pe) != "#/users?page=2" {
+		tests.Failed("Should have resolved route with hash: %q %q", pe.Rem, router.HashMode.URL(pe))
+	}
+	tests.Passed("Should have resolved route with hash")
+
+	pe, _ = router.PathMode.Event("/users?page=2")
+	if pe.Rem != "/users" || router.PathMode.URL(pe) != "/users?page=2" {
+		tests.Failed("Should have resolved route with path: %q %q", pe.Rem, router.PathMode.URL(pe))
+	}
+	tests.Passed("Should have resolved route with path")
+}
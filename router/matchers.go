@@ -15,13 +15,29 @@ var matchers = struct {
 }
 
 // URIMatcher returns a new uri matcher if it has not being already creatd.
+//
+// Besides the syntax of the pattern package, parameters can be constrained to a
+// type or expression and marked as optional, where their segment may be left
+// out of paths:
+//
+//	/users/:id<int>         // int, uint, float, bool, alpha, alnum and uuid.
+//	/posts/:slug<[a-z-]+>   // any expression without "/".
+//	/archive/:year<int>/:month<int>?
+//
+// It panics if the pattern contains an invalid expression.
 func URIMatcher(path string) pattern.URIMatcher {
 	matchers.ml.RLock()
 	mk, ok := matchers.m[path]
 	matchers.ml.RUnlock()
 
 	if !ok {
-		m := pattern.New(path)
+		var m pattern.URIMatcher
+		if typed, ok := newTypedMatcher(path); ok {
+			m = typed
+		} else {
+			m = pattern.New(path)
+		}
+
 		matchers.ml.Lock()
 		matchers.m[path] = m
 		matchers.ml.Unlock()
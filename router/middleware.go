@@ -0,0 +1,209 @@
+package router
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gu-io/gu/router/cache"
+)
+
+// HandlerFunc defines a function type which implements the HTTPCacheHandler
+// interface.
+type HandlerFunc func(http.ResponseWriter, *http.Request, cache.Cache)
+
+// ServeHTTP calls the function with the request, response and cache.
+func (h HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request, c cache.Cache) {
+	h(w, r, c)
+}
+
+// Middleware defines a function which wraps a handler with behaviour applied to
+// every request it handles.
+type Middleware func(HTTPCacheHandler) HTTPCacheHandler
+
+// Chain returns a handler which serves requests through the middlewares and the
+// handler, which must be a HTTPHandler or HTTPCacheHandler. The first middleware
+// is the outermost, receiving requests first.
+func Chain(handler interface{}, middlewares ...Middleware) HTTPCacheHandler {
+	next := cacheHandler(handler)
+
+	for index := len(middlewares) - 1; index >= 0; index-- {
+		next = middlewares[index](next)
+	}
+
+	return next
+}
+
+// cacheHandler returns the handler as a HTTPCacheHandler.
+func cacheHandler(handler interface{}) HTTPCacheHandler {
+	switch hl := handler.(type) {
+	case HTTPCacheHandler:
+		return hl
+	case HTTPHandler:
+		return HandlerFunc(func(w http.ResponseWriter, r *http.Request, _ cache.Cache) {
+			hl.ServeHTTP(w, r)
+		})
+	default:
+		panic("Unsupported handler type")
+	}
+}
+
+// Headers returns a Middleware which sets the headers on every request, such as
+// the Authorization header of a service.
+func Headers(headers map[string]string) Middleware {
+	return RewriteRequest(func(r *http.Request) {
+		for name, value := range headers {
+			r.Header.Set(name, value)
+		}
+	})
+}
+
+// RewriteRequest returns a Middleware which calls the function with every
+// request before it is handled.
+func RewriteRequest(rewrite func(*http.Request)) Middleware {
+	return func(next HTTPCacheHandler) HTTPCacheHandler {
+		return HandlerFunc(func(w http.ResponseWriter, r *http.Request, c cache.Cache) {
+			req := r.WithContext(r.Context())
+			req.Header = cloneHeader(r.Header)
+
+			rewrite(req)
+			next.ServeHTTP(w, req, c)
+		})
+	}
+}
+
+// RewriteResponse returns a Middleware which calls the function with every
+// response before it is written, the function may change the status, headers
+// and body of the response.
+func RewriteResponse(rewrite func(*http.Response)) Middleware {
+	return func(next HTTPCacheHandler) HTTPCacheHandler {
+		return HandlerFunc(func(w http.ResponseWriter, r *http.Request, c cache.Cache) {
+			recorder := httptest.NewRecorder()
+			next.ServeHTTP(recorder, r, c)
+
+			res := recorder.Result()
+			res.Request = r
+
+			rewrite(res)
+			writeResponse(w, res)
+		})
+	}
+}
+
+// Logging returns a Middleware which logs the method, url, status and duration
+// of every request with the function, such as log.Printf.
+func Logging(logf func(string, ...interface{})) Middleware {
+	return func(next HTTPCacheHandler) HTTPCacheHandler {
+		return HandlerFunc(func(w http.ResponseWriter, r *http.Request, c cache.Cache) {
+			start := time.Now()
+
+			recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(recorder, r, c)
+
+			logf("%s %s %d %s", r.Method, r.URL.String(), recorder.status, time.Since(start))
+		})
+	}
+}
+
+// Retry returns a Middleware which retries requests of idempotent methods up to
+// the number of attempts while the handler responds with a server error, waiting
+// for the backoff before the first retry and doubling it on each retry. Waiting
+// stops once the context of the request is done, writing the last response.
+func Retry(attempts int, backoff time.Duration) Middleware {
+	return func(next HTTPCacheHandler) HTTPCacheHandler {
+		return HandlerFunc(func(w http.ResponseWriter, r *http.Request, c cache.Cache) {
+			if attempts < 2 || !idempotent[r.Method] {
+				next.ServeHTTP(w, r, c)
+				return
+			}
+
+			// The body is kept so it can be sent with every attempt.
+			var body []byte
+			if r.Body != nil {
+				body, _ = ioutil.ReadAll(r.Body)
+				r.Body.Close()
+			}
+
+			wait := backoff
+
+			var res *http.Response
+			for attempt := 1; ; attempt++ {
+				req := r.WithContext(r.Context())
+				req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+				recorder := httptest.NewRecorder()
+				next.ServeHTTP(recorder, req, c)
+
+				res = recorder.Result()
+				if res.StatusCode < 500 || attempt >= attempts {
+					break
+				}
+
+				timer := time.NewTimer(wait)
+
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					writeResponse(w, res)
+					return
+				}
+
+				wait *= 2
+			}
+
+			writeResponse(w, res)
+		})
+	}
+}
+
+// idempotent defines the methods whose requests can be retried.
+var idempotent = map[string]bool{
+	"GET":     true,
+	"HEAD":    true,
+	"OPTIONS": true,
+	"PUT":     true,
+	"DELETE":  true,
+}
+
+// statusRecorder defines a http.ResponseWriter which records the status
+// written through it.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status and writes it into the underline writer.
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// writeResponse writes the status, headers and body of the response into the
+// http.ResponseWriter.
+func writeResponse(w http.ResponseWriter, res *http.Response) {
+	for name, values := range res.Header {
+		w.Header()[name] = values
+	}
+
+	w.WriteHeader(res.StatusCode)
+
+	if res.Body != nil {
+		defer res.Body.Close()
+		io.Copy(w, res.Body)
+	}
+}
+
+// cloneHeader returns a copy of the header.
+func cloneHeader(header http.Header) http.Header {
+	cloned := make(http.Header, len(header))
+
+	for name, values := range header {
+		cloned[name] = append([]string(nil), values...)
+	}
+
+	return cloned
+}
@@ -0,0 +1,140 @@
+package router_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/router"
+	"github.com/influx6/faux/tests"
+)
+
+// flaky defines a handler which fails until it's number of failures is
+// reached, echoing the body and Authorization header of requests after.
+type flaky struct {
+	ml       sync.Mutex
+	failures int
+	requests int
+}
+
+func (f *flaky) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.ml.Lock()
+	defer f.ml.Unlock()
+
+	f.requests++
+
+	if f.requests <= f.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var body []byte
+	if r.Body != nil {
+		body, _ = ioutil.ReadAll(r.Body)
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "%s %s", r.Header.Get("Authorization"), body)
+}
+
+func TestMiddlewareChain(t *testing.T) {
+	var order []string
+
+	trace := func(name string) router.Middleware {
+		return router.RewriteRequest(func(r *http.Request) {
+			order = append(order, name)
+		})
+	}
+
+	rt := router.NewRouter(&flaky{}, nil)
+	rt.Use(trace("first"), trace("second"))
+	rt.Use(router.Headers(map[string]string{"Authorization": "Bearer token"}))
+
+	res, err := rt.Post("/resource", nil, ioutil.NopCloser(strings.NewReader("body")))
+	if err != nil {
+		tests.Failed("Should have made request through middlewares: %+q", err)
+	}
+	tests.Passed("Should have made request through middlewares")
+
+	if body, _ := router.ReadBody(res); string(body) != "Bearer token body" {
+		tests.Failed("Should have set headers of request: %q", body)
+	}
+	tests.Passed("Should have set headers of request")
+
+	if strings.Join(order, ",") != "first,second" {
+		tests.Failed("Should have called middlewares in order: %+q", order)
+	}
+	tests.Passed("Should have called middlewares in order")
+}
+
+func TestMiddlewareRetry(t *testing.T) {
+	handler := &flaky{failures: 2}
+
+	rt := router.NewRouter(handler, nil)
+	rt.Use(router.Retry(3, time.Millisecond))
+
+	res, err := rt.Put("/resource", nil, ioutil.NopCloser(strings.NewReader("body")))
+	if err != nil || res.StatusCode != http.StatusOK {
+		tests.Failed("Should have retried failed request: %+q", err)
+	}
+	tests.Passed("Should have retried failed request")
+
+	if body, _ := router.ReadBody(res); string(body) != " body" || handler.requests != 3 {
+		tests.Failed("Should have sent body with every attempt: %q %d", body, handler.requests)
+	}
+	tests.Passed("Should have sent body with every attempt")
+
+	handler = &flaky{failures: 5}
+
+	rt = router.NewRouter(handler, nil)
+	rt.Use(router.Retry(3, time.Millisecond))
+
+	if res, _ := rt.Get("/resource", nil); res.StatusCode != http.StatusServiceUnavailable || handler.requests != 3 {
+		tests.Failed("Should have stopped after attempts: %d %d", res.StatusCode, handler.requests)
+	}
+	tests.Passed("Should have stopped after attempts")
+
+	handler = &flaky{failures: 1}
+
+	rt = router.NewRouter(handler, nil)
+	rt.Use(router.Retry(3, time.Millisecond))
+
+	if res, _ := rt.Post("/resource", nil, nil); res.StatusCode != http.StatusServiceUnavailable || handler.requests != 1 {
+		tests.Failed("Should have not retried POST request: %d", handler.requests)
+	}
+	tests.Passed("Should have not retried POST request")
+}
+
+func TestMiddlewareRewriteResponse(t *testing.T) {
+	var logs []string
+
+	rt := router.NewRouter(&flaky{}, nil)
+	rt.Use(
+		router.Logging(func(format string, args ...interface{}) {
+			logs = append(logs, fmt.Sprintf(format, args...))
+		}),
+		router.RewriteResponse(func(res *http.Response) {
+			res.StatusCode = http.StatusAccepted
+			res.Header.Set("Content-Type", "text/html")
+			res.Body = ioutil.NopCloser(bytes.NewBufferString("rewritten"))
+		}),
+	)
+
+	res, _ := rt.Get("/resource", nil)
+
+	if body, _ := router.ReadBody(res); res.StatusCode != http.StatusAccepted || string(body) != "rewritten" || res.Header.Get("Content-Type") != "text/html" {
+		tests.Failed("Should have rewritten response: %d %q", res.StatusCode, body)
+	}
+	tests.Passed("Should have rewritten response")
+
+	if len(logs) != 1 || !strings.HasPrefix(logs[0], "GET /resource 202 ") {
+		tests.Failed("Should have logged request: %+q", logs)
+	}
+	tests.Passed("Should have logged request")
+}
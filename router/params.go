@@ -0,0 +1,324 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/influx6/faux/pattern"
+)
+
+var (
+	// ErrRouteMismatch is returned when a path does not match the segments of a
+	// route pattern.
+	ErrRouteMismatch = errors.New("Route does not match path")
+
+	// ErrParamNotFound is returned when a route has no parameter with a name.
+	ErrParamNotFound = errors.New("Route param not found")
+)
+
+// ParamError defines the error returned when the value of a parameter does not
+// match the constraint of it's route pattern, which is delivered with the
+// PushEvent given to the Failed handlers of resolvers.
+type ParamError struct {
+	Name       string
+	Value      string
+	Constraint string
+}
+
+// Error returns the message of the error.
+func (p ParamError) Error() string {
+	return fmt.Sprintf("Route param %q value %q does not match %q", p.Name, p.Value, p.Constraint)
+}
+
+// constraints defines the expressions of the named constraints of parameters,
+// used as `:id<int>`.
+var constraints = map[string]string{
+	"int":   `-?\d+`,
+	"uint":  `\d+`,
+	"float": `-?\d+(\.\d+)?`,
+	"bool":  `true|false|1|0`,
+	"alpha": `[a-zA-Z]+`,
+	"alnum": `[a-zA-Z0-9]+`,
+	"uuid":  `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`,
+}
+
+// typedParam matches the parameter segments with a constraint or marked as
+// optional, eg. `:id<int>`, `:slug<[a-z-]+>`, `:page?` and `#:tab<alpha>?`.
+var typedParam = regexp.MustCompile(`^(#?):(\w+)(?:<(.+)>)?(\?)?$`)
+
+// segment defines a single segment of a route pattern.
+type segment struct {
+	raw        string
+	value      string
+	param      bool
+	hashed     bool
+	optional   bool
+	constraint string
+	matcher    *regexp.Regexp
+}
+
+// plain returns the segment in the syntax of the pattern package.
+func (s segment) plain() string {
+	if s.raw != "" {
+		return s.raw
+	}
+
+	value := s.value
+	if s.param {
+		value = ":" + value
+	}
+
+	if s.hashed {
+		value = "#" + value
+	}
+
+	return value
+}
+
+// validate returns an error if the value does not match the segment.
+func (s segment) validate(value string) error {
+	if s.matcher != nil && !s.matcher.MatchString(value) {
+		return ParamError{Name: s.value, Value: value, Constraint: s.constraint}
+	}
+
+	return nil
+}
+
+// typedMatcher implements the pattern.URIMatcher for route patterns with typed
+// or optional parameters, matching paths against the patterns made of each
+// combination of it's optional segments, longest first.
+type typedMatcher struct {
+	pattern  string
+	segments []segment
+	variants []pattern.URIMatcher
+}
+
+// Pattern returns the pattern string for this matcher.
+func (t *typedMatcher) Pattern() string {
+	return t.pattern
+}
+
+// Priority returns the priority of the pattern.
+func (t *typedMatcher) Priority() int {
+	return t.variants[0].Priority()
+}
+
+// Validate returns true/false if the path matches the pattern, returning the
+// parameters of the path and it's remaining path.
+func (t *typedMatcher) Validate(path string) (pattern.Params, string, bool) {
+	params, rem, err := t.check(path)
+	return params, rem, err == nil
+}
+
+// check returns the parameters and remaining path of the path, returning an
+// error if it does not match the pattern, which is a ParamError if the path
+// matched the segments of the pattern but not the constraint of a parameter.
+func (t *typedMatcher) check(path string) (pattern.Params, string, error) {
+	failure := ErrRouteMismatch
+
+	for _, variant := range t.variants {
+		params, rem, ok := variant.Validate(path)
+		if !ok {
+			continue
+		}
+
+		if err := t.validate(params); err != nil {
+			if failure == ErrRouteMismatch {
+				failure = err
+			}
+
+			continue
+		}
+
+		return params, rem, nil
+	}
+
+	return nil, "", failure
+}
+
+// validate returns an error if any of the parameters do not match the
+// constraints of their segments.
+func (t *typedMatcher) validate(params pattern.Params) error {
+	for _, segment := range t.segments {
+		if !segment.param {
+			continue
+		}
+
+		value, ok := params[segment.value]
+		if !ok {
+			continue
+		}
+
+		if err := segment.validate(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newTypedMatcher returns a new typedMatcher for the pattern, returning false if
+// the pattern has no typed or optional parameters.
+func newTypedMatcher(path string) (*typedMatcher, bool) {
+	segments, typed := parseSegments(path)
+	if !typed {
+		return nil, false
+	}
+
+	endless := pattern.IsEndless(path)
+
+	var optionals []int
+	for index, segment := range segments {
+		if segment.optional {
+			optionals = append(optionals, index)
+		}
+	}
+
+	var variants []pattern.URIMatcher
+
+	// Each bit of the mask omits an optional segment, where lower masks keep
+	// more segments and are tried first.
+	for size := 0; size <= len(optionals); size++ {
+		for mask := 0; mask < 1<<uint(len(optionals)); mask++ {
+			if bitCount(mask) != size {
+				continue
+			}
+
+			omitted := make(map[int]bool)
+			for bit, index := range optionals {
+				if mask&(1<<uint(bit)) != 0 {
+					omitted[index] = true
+				}
+			}
+
+			var parts []string
+			for index, segment := range segments {
+				if !omitted[index] {
+					parts = append(parts, segment.plain())
+				}
+			}
+
+			variant := "/" + strings.Join(parts, "/")
+			if endless {
+				variant = strings.TrimSuffix(variant, "/") + "/*"
+			}
+
+			variants = append(variants, pattern.New(variant))
+		}
+	}
+
+	return &typedMatcher{
+		pattern:  path,
+		segments: segments,
+		variants: variants,
+	}, true
+}
+
+// parseSegments returns the segments of the pattern without the trailing "*",
+// returning true if any of them are typed or optional parameters. It panics if
+// the constraint of a parameter is not a valid expression.
+func parseSegments(path string) ([]segment, bool) {
+	var typed bool
+	var segments []segment
+
+	path = strings.TrimSuffix(strings.TrimSuffix(path, "*"), "/")
+
+	for _, part := range strings.Split(path, "/") {
+		if part == "" {
+			continue
+		}
+
+		parts := typedParam.FindStringSubmatch(part)
+		if parts == nil || (parts[3] == "" && parts[4] == "") {
+			item := plainSegment(pattern.Segment(part))
+			item.raw = part
+
+			segments = append(segments, item)
+			continue
+		}
+
+		typed = true
+
+		item := segment{
+			value:      parts[2],
+			param:      true,
+			hashed:     parts[1] == "#",
+			optional:   parts[4] == "?",
+			constraint: parts[3],
+		}
+
+		if item.constraint != "" {
+			expr, ok := constraints[item.constraint]
+			if !ok {
+				expr = item.constraint
+			}
+
+			item.matcher = regexp.MustCompile("^(?:" + expr + ")$")
+		}
+
+		segments = append(segments, item)
+	}
+
+	return segments, typed
+}
+
+// plainSegment returns the segment of a segment in the syntax of the pattern
+// package, whose parameters are validated with it's unanchored expression.
+func plainSegment(matcher pattern.Matchable) segment {
+	item := segment{
+		value:  matcher.Segment(),
+		param:  matcher.IsParam(),
+		hashed: matcher.HasHash(),
+	}
+
+	if rx, ok := matcher.(*pattern.SegmentMatcher); ok && item.param {
+		item.constraint = rx.String()
+		item.matcher = rx.Regexp
+	}
+
+	return item
+}
+
+// routeSegments returns the segments of the pattern of the matcher.
+func routeSegments(matcher pattern.URIMatcher) []segment {
+	if typed, ok := matcher.(*typedMatcher); ok {
+		return typed.segments
+	}
+
+	var segments []segment
+	for _, item := range pattern.SegmentList(matcher.Pattern()) {
+		if item.Segment() != "/" {
+			segments = append(segments, plainSegment(item))
+		}
+	}
+
+	return segments
+}
+
+// checkPath validates the path with the matcher, returning ErrRouteMismatch if
+// it does not match or the ParamError of a parameter not matching it's
+// constraint.
+func checkPath(matcher pattern.URIMatcher, path string) (pattern.Params, string, error) {
+	if typed, ok := matcher.(*typedMatcher); ok {
+		return typed.check(path)
+	}
+
+	params, rem, ok := matcher.Validate(path)
+	if !ok {
+		return nil, "", ErrRouteMismatch
+	}
+
+	return params, rem, nil
+}
+
+// bitCount returns the number of set bits of the value.
+func bitCount(value int) int {
+	var count int
+	for ; value != 0; value &= value - 1 {
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,144 @@
+package router_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/router"
+	"github.com/influx6/faux/tests"
+)
+
+func TestTypedParams(t *testing.T) {
+	rx := router.NewResolver("/users/:id<int>")
+
+	if params, _, ok := rx.Test("/users/12"); !ok || params["id"] != "12" {
+		tests.Failed("Should have matched typed param: %+q", params)
+	}
+	tests.Passed("Should have matched typed param")
+
+	if _, _, ok := rx.Test("/users/abc"); ok {
+		tests.Failed("Should have not matched param of wrong type")
+	}
+	tests.Passed("Should have not matched param of wrong type")
+
+	var failure error
+	rx.Failed(func(px router.PushEvent) {
+		failure = px.Err
+	})
+
+	rx.Resolve(router.UseLocation("/users/12a"))
+
+	if err, ok := failure.(router.ParamError); !ok || err.Name != "id" || err.Value != "12a" || err.Constraint != "int" {
+		tests.Failed("Should have delivered param error to failed handlers: %+q", failure)
+	}
+	tests.Passed("Should have delivered param error to failed handlers")
+
+	rx.Resolve(router.UseLocation("/posts/12"))
+
+	if failure != router.ErrRouteMismatch {
+		tests.Failed("Should have delivered mismatch error to failed handlers: %+q", failure)
+	}
+	tests.Passed("Should have delivered mismatch error to failed handlers")
+
+	slug := router.NewResolver(`/posts/:slug<[a-z-]+>`)
+
+	if _, _, ok := slug.Test("/posts/hello-world"); !ok {
+		tests.Failed("Should have matched param with expression")
+	}
+
+	if _, _, ok := slug.Test("/posts/Hello"); ok {
+		tests.Failed("Should have anchored expression of param")
+	}
+	tests.Passed("Should have matched param with expression")
+}
+
+func TestOptionalParams(t *testing.T) {
+	rx := router.NewResolver("/archive/:year<int>/:month<int>?")
+
+	if params, _, ok := rx.Test("/archive/2017/05"); !ok || params["year"] != "2017" || params["month"] != "05" {
+		tests.Failed("Should have matched path with optional segment: %+q", params)
+	}
+	tests.Passed("Should have matched path with optional segment")
+
+	if params, _, ok := rx.Test("/archive/2017"); !ok || params["year"] != "2017" {
+		tests.Failed("Should have matched path without optional segment: %+q", params)
+	}
+	tests.Passed("Should have matched path without optional segment")
+
+	if _, _, ok := rx.Test("/archive/2017/may"); ok {
+		tests.Failed("Should have validated optional segment")
+	}
+	tests.Passed("Should have validated optional segment")
+
+	docs := router.NewResolver("/docs/:section?/*")
+
+	if params, rem, ok := docs.Test("/docs/guides/install"); !ok || params["section"] != "guides" || rem != "/install" {
+		tests.Failed("Should have matched endless path with optional segment: %+q %q", params, rem)
+	}
+
+	if _, rem, ok := docs.Test("/docs"); !ok || rem != "" {
+		tests.Failed("Should have matched endless path without optional segment: %q", rem)
+	}
+	tests.Passed("Should have matched endless path with optional segments")
+
+	routes := router.NewRoutes()
+	routes.MustAdd("archive", "/archive/:year<int>/:month<int>?")
+
+	if path, err := routes.URLFor("archive", router.Params{"year": "2017"}); err != nil || path != "/archive/2017" {
+		tests.Failed("Should have built url without optional segment: %q %+q", path, err)
+	}
+
+	if path, err := routes.URLFor("archive", router.Params{"year": "2017", "month": "05"}); err != nil || path != "/archive/2017/05" {
+		tests.Failed("Should have built url with optional segment: %q %+q", path, err)
+	}
+	tests.Passed("Should have built urls of typed routes")
+
+	if _, err := routes.URLFor("archive", router.Params{"year": "last"}); err == nil {
+		tests.Failed("Should have failed to build url with param of wrong type")
+	}
+	tests.Passed("Should have failed to build url with param of wrong type")
+}
+
+func TestPushEventQuery(t *testing.T) {
+	event := router.UseLocation("/users/12?page=2&tag=a&tag=b")
+
+	if event.Rem != "/users/12" || event.Query.Get("page") != "2" || len(event.Query["tag"]) != 2 {
+		tests.Failed("Should have parsed query of path: %q %+q", event.Rem, event.Query)
+	}
+	tests.Passed("Should have parsed query of path")
+
+	event = router.UseLocationHash("/?lang=en#/users/12?page=3")
+
+	if event.Rem != "/users/12" || event.Query.Get("page") != "3" || event.Query.Get("lang") != "en" {
+		tests.Failed("Should have parsed query of hash: %q %+q", event.Rem, event.Query)
+	}
+	tests.Passed("Should have parsed query of hash")
+
+	var resolved router.PushEvent
+
+	rx := router.NewResolver("/users/:id<int>")
+	rx.Done(func(px router.PushEvent) {
+		resolved = px
+	})
+
+	rx.Resolve(event)
+
+	if id, err := resolved.ParamInt("id"); err != nil || id != 12 {
+		tests.Failed("Should have converted param: %d %+q", id, err)
+	}
+	tests.Passed("Should have converted param")
+
+	if page, err := resolved.QueryInt("page", 1); err != nil || page != 3 {
+		tests.Failed("Should have passed query to resolved event: %d %+q", page, err)
+	}
+	tests.Passed("Should have passed query to resolved event")
+
+	if size, err := resolved.QueryInt("size", 20); err != nil || size != 20 {
+		tests.Failed("Should have returned default of missing query: %d %+q", size, err)
+	}
+	tests.Passed("Should have returned default of missing query")
+
+	if _, err := resolved.ParamBool("missing"); err != router.ErrParamNotFound {
+		tests.Failed("Should have failed to convert missing param: %+q", err)
+	}
+	tests.Passed("Should have failed to convert missing param")
+}
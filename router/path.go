@@ -3,6 +3,7 @@ package router
 import (
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/gu-io/gu/notifications"
@@ -20,12 +21,17 @@ func WrapHandler(fx func()) func(PushEvent) {
 }
 
 // PushDirectiveEvent defines a event which is used to declare the switching
-// of the route to another path provided.
+// of the route to another path provided. Replace replaces the current route in
+// the history of the Location instead of pushing a new one.
 type PushDirectiveEvent struct {
-	To string
+	To      string
+	Replace bool
 }
 
-// PushEvent represent the current path and hash values.
+// PushEvent represent the current path and hash values, with the values of the
+// query of the path and hash. Err contains the reason a resolver failed to
+// match the event when given to it's Failed handlers, which is a ParamError
+// for values not matching the constraints of parameters.
 //
 //@notification:event
 type PushEvent struct {
@@ -36,6 +42,8 @@ type PushEvent struct {
 	To     string
 	From   string
 	Params map[string]string
+	Query  url.Values
+	Err    error
 }
 
 // NewPushEvent returns PushEvent based on the path string provided.
@@ -45,7 +53,24 @@ func NewPushEvent(path string, useHash bool) (PushEvent, error) {
 		return PushEvent{}, err
 	}
 
+	query := ups.Query()
+
 	hash := strings.TrimSpace(ups.Fragment)
+
+	// Queries within the hash are added to the query of the path.
+	if index := strings.Index(hash, "?"); index != -1 {
+		values, err := url.ParseQuery(hash[index+1:])
+		if err != nil {
+			return PushEvent{}, err
+		}
+
+		for key, value := range values {
+			query[key] = append(query[key], value...)
+		}
+
+		hash = hash[:index]
+	}
+
 	if hash == "" {
 		hash = "/#"
 	}
@@ -65,9 +90,83 @@ func NewPushEvent(path string, useHash bool) (PushEvent, error) {
 		Rem:    target,
 		From:   ups.String(),
 		Params: make(map[string]string),
+		Query:  query,
 	}, nil
 }
 
+// Param returns the value of the route parameter with the name, returning
+// ErrParamNotFound if there is none.
+func (p PushEvent) Param(name string) (string, error) {
+	value, ok := p.Params[name]
+	if !ok {
+		return "", ErrParamNotFound
+	}
+
+	return value, nil
+}
+
+// ParamInt returns the value of the route parameter with the name as an int.
+func (p PushEvent) ParamInt(name string) (int, error) {
+	value, err := p.Param(name)
+	if err != nil {
+		return 0, err
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("Route param %q: %s", name, err.Error())
+	}
+
+	return number, nil
+}
+
+// ParamFloat returns the value of the route parameter with the name as a
+// float64.
+func (p PushEvent) ParamFloat(name string) (float64, error) {
+	value, err := p.Param(name)
+	if err != nil {
+		return 0, err
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Route param %q: %s", name, err.Error())
+	}
+
+	return number, nil
+}
+
+// ParamBool returns the value of the route parameter with the name as a bool.
+func (p PushEvent) ParamBool(name string) (bool, error) {
+	value, err := p.Param(name)
+	if err != nil {
+		return false, err
+	}
+
+	state, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("Route param %q: %s", name, err.Error())
+	}
+
+	return state, nil
+}
+
+// QueryInt returns the first query value with the name as an int, returning
+// the value of def if the query has none.
+func (p PushEvent) QueryInt(name string, def int) (int, error) {
+	value := p.Query.Get(name)
+	if value == "" {
+		return def, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return def, fmt.Errorf("Route query %q: %s", name, err.Error())
+	}
+
+	return number, nil
+}
+
 // String returns the hash and path.
 func (p PushEvent) String() string {
 	return fmt.Sprintf("%s%s", pattern.TrimEndSlashe(p.Path), p.Hash)
@@ -123,7 +222,8 @@ func ListenFor(hash bool, pattern string, fx func(PushEvent), fail func(PushEven
 			target = p.String()
 		}
 
-		if params, rem, found := matcher.Validate(target); found {
+		params, rem, err := checkPath(matcher, target)
+		if err == nil {
 			for key, val := range p.Params {
 				if _, ok := params[key]; !ok {
 					params[key] = val
@@ -137,12 +237,14 @@ func ListenFor(hash bool, pattern string, fx func(PushEvent), fail func(PushEven
 				Hash:   p.Hash,
 				Host:   p.Host,
 				From:   p.From,
+				Query:  p.Query,
 			})
 
 			return
 		}
 
 		if fail != nil {
+			p.Err = err
 			fail(p)
 		}
 	}))
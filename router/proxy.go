@@ -0,0 +1,114 @@
+package router
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidBaseURL is returned when the base url of a ProxyHandler is not an
+// absolute url.
+var ErrInvalidBaseURL = errors.New("Base URL must be absolute")
+
+// hopHeaders defines the headers which apply to a single connection and are
+// not forwarded by a ProxyHandler.
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// ProxyHandler defines a HTTPHandler which forwards requests to a remote server
+// using a http.Client, resolving their paths against a base url. It allows a
+// Router to service requests it has no handler for from the network, such as
+// the fallback of a Multiplexer.
+type ProxyHandler struct {
+	base   *url.URL
+	client *http.Client
+}
+
+// NewProxyHandler returns a new instance of a ProxyHandler forwarding requests to
+// the base url with the client, using http.DefaultClient if none is provided.
+func NewProxyHandler(base string, client *http.Client) (*ProxyHandler, error) {
+	uri, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+
+	if !uri.IsAbs() || uri.Host == "" {
+		return nil, ErrInvalidBaseURL
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &ProxyHandler{
+		base:   uri,
+		client: client,
+	}, nil
+}
+
+// URL returns the url the request is forwarded to, made of the base url joined
+// with the path of the request and the query of both.
+func (p *ProxyHandler) URL(r *http.Request) *url.URL {
+	target := *p.base
+	target.Path = strings.TrimSuffix(p.base.Path, "/") + "/" + strings.TrimPrefix(r.URL.Path, "/")
+	target.RawPath = ""
+
+	switch {
+	case p.base.RawQuery == "":
+		target.RawQuery = r.URL.RawQuery
+	case r.URL.RawQuery != "":
+		target.RawQuery = p.base.RawQuery + "&" + r.URL.RawQuery
+	}
+
+	return &target
+}
+
+// ServeHTTP forwards the request to the remote server, writing it's response.
+// Failures to reach the server are answered with a 502 Bad Gateway, or a 504
+// Gateway Timeout when the context of the request is done.
+func (p *ProxyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body io.Reader
+	if r.Body != nil && r.Body != http.NoBody {
+		body = r.Body
+	}
+
+	req, err := http.NewRequest(r.Method, p.URL(r).String(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	req = req.WithContext(r.Context())
+	req.Header = cloneHeader(r.Header)
+
+	for _, name := range hopHeaders {
+		req.Header.Del(name)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		if r.Context().Err() != nil {
+			http.Error(w, err.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	for _, name := range hopHeaders {
+		res.Header.Del(name)
+	}
+
+	writeResponse(w, res)
+}
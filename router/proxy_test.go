@@ -0,0 +1,93 @@
+package router_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/router"
+	"github.com/influx6/faux/tests"
+)
+
+func TestProxyHandler(t *testing.T) {
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/slow" {
+			<-r.Context().Done()
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+
+		w.Header().Set("X-Remote", "yes")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, "%s %s %s %s", r.Method, r.URL.RequestURI(), r.Header.Get("Authorization"), body)
+	}))
+	defer remote.Close()
+
+	proxy, err := router.NewProxyHandler(remote.URL+"/api/?key=1", nil)
+	if err != nil {
+		tests.Failed("Should have created proxy handler: %+q", err)
+	}
+	tests.Passed("Should have created proxy handler")
+
+	local := router.NewMux("/local", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("local"))
+	}))
+
+	rt := router.NewRouter(router.NewMultiplexer(local).WithFallback(proxy), nil)
+	rt.Use(router.Headers(map[string]string{"Authorization": "Bearer token"}))
+
+	res, err := rt.Get("/local/users", nil)
+	if body, _ := router.ReadBody(res); err != nil || string(body) != "local" {
+		tests.Failed("Should have served matched request locally: %+q", err)
+	}
+	tests.Passed("Should have served matched request locally")
+
+	res, err = rt.Post("/users?page=2", nil, ioutil.NopCloser(strings.NewReader("body")))
+	if err != nil {
+		tests.Failed("Should have forwarded unmatched request: %+q", err)
+	}
+	tests.Passed("Should have forwarded unmatched request")
+
+	if body, _ := router.ReadBody(res); string(body) != "POST /api/users?key=1&page=2 Bearer token body" {
+		tests.Failed("Should have forwarded method, url, headers and body: %q", body)
+	}
+	tests.Passed("Should have forwarded method, url, headers and body")
+
+	if res.StatusCode != http.StatusCreated || res.Header.Get("X-Remote") != "yes" {
+		tests.Failed("Should have written remote response: %d", res.StatusCode)
+	}
+	tests.Passed("Should have written remote response")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := rt.GetContext(ctx, "/slow", nil); err != context.DeadlineExceeded {
+		tests.Failed("Should have cancelled forwarded request: %+q", err)
+	}
+	tests.Passed("Should have cancelled forwarded request")
+
+	if _, err := router.NewProxyHandler("/api", nil); err != router.ErrInvalidBaseURL {
+		tests.Failed("Should have rejected relative base url: %+q", err)
+	}
+	tests.Passed("Should have rejected relative base url")
+}
+
+func TestProxyHandlerUnreachable(t *testing.T) {
+	remote := httptest.NewServer(http.NotFoundHandler())
+	remote.Close()
+
+	proxy, _ := router.NewProxyHandler(remote.URL, nil)
+
+	res, err := router.NewRouter(proxy, nil).Get("/users", nil)
+	if err != nil || res.StatusCode != http.StatusBadGateway {
+		tests.Failed("Should have responded with bad gateway: %+q", err)
+	}
+	tests.Passed("Should have responded with bad gateway")
+}
@@ -116,8 +116,9 @@ func (b *basicResolver) Resolve(path PushEvent) {
 		return
 	}
 
-	params, rem, ok := b.matcher.Validate(path.Rem)
-	if !ok {
+	params, rem, err := checkPath(b.matcher, path.Rem)
+	if err != nil {
+		path.Err = err
 
 		// Notify the fail subscribers.
 		for _, sub := range b.fails {
@@ -140,6 +141,7 @@ func (b *basicResolver) Resolve(path PushEvent) {
 		Path:   path.Path,
 		From:   path.Rem,
 		To:     rem,
+		Query:  path.Query,
 	}
 
 	// Notify the subscribers.
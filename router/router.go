@@ -2,6 +2,7 @@ package router
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/gu-io/gu/router/cache"
 	"github.com/influx6/faux/pattern"
@@ -31,7 +33,8 @@ type HTTPHandler interface {
 
 // HTTPCacheHandler defines a handler which implements a type which allows a
 // handler to have access to a current request and response with the underline
-// cache being used.
+// cache being used. The context of the request carries the deadline and
+// cancellation of the Router request, which handlers should respect.
 type HTTPCacheHandler interface {
 	ServeHTTP(http.ResponseWriter, *http.Request, cache.Cache)
 }
@@ -55,18 +58,29 @@ type server interface {
 // Router exposes a struct which describes a multi-handler of request where
 // it
 type Router struct {
-	cache cache.Cache
-	sx    server
+	cache   cache.Cache
+	policy  *cache.Policy
+	sx      server
+	scope   *Scope
+	timeout time.Duration
+	chain   []Middleware
 }
 
-// NewRouter returns a new instance of a Router.
-func NewRouter(handler interface{}, cache cache.Cache) *Router {
+// NewRouter returns a new instance of a Router. If a cache is provided, the
+// responses of requests are stored and served from it according to the HTTP
+// caching rules, using the cache.Strategy of the Mux which handles a request or
+// cache.CacheFirst by default.
+func NewRouter(handler interface{}, c cache.Cache) *Router {
 	if handler == nil {
 		handler = NilServer{}
 	}
 
 	var router Router
-	router.cache = cache
+	router.cache = c
+
+	if c != nil {
+		router.policy = cache.NewPolicy(c, cache.CacheFirst)
+	}
 
 	switch hl := handler.(type) {
 	case []Mux:
@@ -93,6 +107,34 @@ func (r *Router) Cache() cache.Cache {
 	return r.cache
 }
 
+// Policy returns the caching policy used by the router, it returns nil if the
+// router has no cache.
+func (r *Router) Policy() *cache.Policy {
+	return r.policy
+}
+
+// SetTimeout sets the maximum duration of requests made through the router,
+// after which they are cancelled and return context.DeadlineExceeded. A zero
+// duration disables the timeout.
+func (r *Router) SetTimeout(timeout time.Duration) {
+	r.timeout = timeout
+}
+
+// Use adds the middlewares to the chain of middlewares which wrap the handler of
+// every request made through the router, after the ones already added.
+func (r *Router) Use(middlewares ...Middleware) {
+	r.chain = append(r.chain, middlewares...)
+}
+
+// WithScope returns a copy of the router whose requests belong to the Scope,
+// being cancelled when the Scope is cancelled.
+func (r *Router) WithScope(scope *Scope) *Router {
+	router := *r
+	router.scope = scope
+
+	return &router
+}
+
 // Patch retrieves the giving path and returns the response expected using a PATCH method.
 func (r *Router) Patch(path string, params Params, body io.ReadCloser) (*http.Response, error) {
 	return r.Do("PATCH", path, params, body)
@@ -128,24 +170,49 @@ func (r *Router) Get(path string, params Params) (*http.Response, error) {
 	return r.Do("GET", path, params, nil)
 }
 
+// GetContext retrieves the giving path and returns the response expected using a GET
+// method, returning the error of the context if it is done before the response.
+func (r *Router) GetContext(ctx context.Context, path string, params Params) (*http.Response, error) {
+	return r.DoContext(ctx, "GET", path, params, nil)
+}
+
 // Do performs the giving requests for a giving path with the provided body and returns the
 // response for that method.
 func (r *Router) Do(method string, path string, params Params, body io.ReadCloser) (*http.Response, error) {
+	return r.DoContext(context.Background(), method, path, params, body)
+}
+
+// DoContext performs the giving requests for a giving path with the provided body and
+// returns the response for that method. The context is set as the context of the
+// request given to the handler, and the error of the context is returned if it is
+// done before the handler responds, with the timeout of the router and the
+// cancellation of it's Scope applied.
+func (r *Router) DoContext(ctx context.Context, method string, path string, params Params, body io.ReadCloser) (*http.Response, error) {
+	// The full path keys cached responses, as the matched path no longer has
+	// the namespace of the Mux which handles it.
+	key := cacheKey(path, params)
+
 	path, handler, err := r.sx.Match(path)
 	if err != nil {
 		return nil, err
 	}
 
-	// Do we have parameters?
-	if params != nil {
-		parameters := WrapParams(params)
+	path = withParams(path, params)
 
-		// Does it already contain a query part?
-		if strings.Contains(path, "?") {
-			path = path + "&" + url.QueryEscape(parameters)
-		} else {
-			path = path + "?" + url.QueryEscape(parameters)
-		}
+	if r.scope != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = r.scope.bind(ctx)
+		defer cancel()
+	}
+
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest(method, path, body)
@@ -153,16 +220,46 @@ func (r *Router) Do(method string, path string, params Params, body io.ReadClose
 		return nil, err
 	}
 
+	req = req.WithContext(ctx)
+
 	// Create a ResponseRecorder for the giving
 	responseRecoder := httptest.NewRecorder()
 
-	switch r.cache == nil {
-	case true:
-		handler.ServeHTTP(responseRecoder, req, nil)
-	case false:
-		if err := r.cache.Serve(responseRecoder, req); err != nil {
-			handler.ServeHTTP(responseRecoder, req, r.cache)
+	var strategy cache.Strategy
+	if sh, ok := handler.(strategyHandler); ok {
+		strategy = sh.Strategy()
+	}
+
+	if len(r.chain) != 0 {
+		handler = Chain(handler, r.chain...)
+	}
+
+	// The handler runs in it's own goroutine so the request can return once
+	// the context is done, panics are raised back in the caller.
+	done := make(chan interface{}, 1)
+
+	go func() {
+		defer func() {
+			done <- recover()
+		}()
+
+		switch r.cache == nil {
+		case true:
+			handler.ServeHTTP(responseRecoder, req, nil)
+		case false:
+			r.policy.ServeKey(responseRecoder, req, key, strategy, http.HandlerFunc(func(w http.ResponseWriter, rq *http.Request) {
+				handler.ServeHTTP(w, rq, r.cache)
+			}))
+		}
+	}()
+
+	select {
+	case failure := <-done:
+		if failure != nil {
+			panic(failure)
 		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 
 	res := responseRecoder.Result()
@@ -171,6 +268,44 @@ func (r *Router) Do(method string, path string, params Params, body io.ReadClose
 	return res, nil
 }
 
+// withParams returns the path with the params added to it's query.
+func withParams(path string, params Params) string {
+	if params == nil {
+		return path
+	}
+
+	parameters := WrapParams(params)
+
+	// Does it already contain a query part?
+	if strings.Contains(path, "?") {
+		return path + "&" + url.QueryEscape(parameters)
+	}
+
+	return path + "?" + url.QueryEscape(parameters)
+}
+
+// cacheKey returns the key of the responses of the path with the params, where
+// the params are added to the query of the path sorted by name, so the key is
+// the same for equal params.
+func cacheKey(path string, params Params) string {
+	if len(params) == 0 {
+		return path
+	}
+
+	uri, err := url.Parse(path)
+	if err != nil {
+		return withParams(path, params)
+	}
+
+	query := uri.Query()
+	for name, value := range params {
+		query.Set(name, value)
+	}
+
+	uri.RawQuery = query.Encode()
+	return uri.String()
+}
+
 //================================================================================
 
 // HandleMux defines a structure which handles the variaties in the supported request
@@ -179,6 +314,13 @@ type HandleMux struct {
 	normal     HTTPHandler
 	caches     HTTPCacheHandler
 	preprocess PreprocessHandler
+	strategy   cache.Strategy
+}
+
+// strategyHandler defines a handler which provides the cache.Strategy used for
+// the requests it handles.
+type strategyHandler interface {
+	Strategy() cache.Strategy
 }
 
 // NewHandleMux returns a new instance of a HandleMux.
@@ -213,6 +355,11 @@ func (m HandleMux) Match(path string) (string, HTTPCacheHandler, error) {
 	return path, m, nil
 }
 
+// Strategy returns the cache.Strategy used for requests handled by the HandleMux.
+func (m HandleMux) Strategy() cache.Strategy {
+	return m.strategy
+}
+
 // ServeAndCache attempts to service request with either the cache or normal handler found within
 // itself.
 func (m HandleMux) ServeHTTP(w http.ResponseWriter, r *http.Request, c cache.Cache) {
@@ -271,6 +418,15 @@ func NewMux(namespace string, handler interface{}) Mux {
 	return mx
 }
 
+// NewMuxWithStrategy returns a new instance of a mux, whose requests are served
+// from the cache of the Router with the giving strategy.
+func NewMuxWithStrategy(namespace string, handler interface{}, strategy cache.Strategy) Mux {
+	mx := NewMux(namespace, handler)
+	mx.handler.strategy = strategy
+
+	return mx
+}
+
 // Match validates that the giving Mux matches the wanted path and
 // extracts the real path from the provided path, returning the true/false
 // if it matched the path.
@@ -288,7 +444,8 @@ func (m *Mux) Match(path string) (string, HTTPCacheHandler, error) {
 // Multiplexer defines a struct which manages giving set of Mux and adequates
 // calls the first to match a giving request about a incoming request.
 type Multiplexer struct {
-	mux []Mux
+	mux      []Mux
+	fallback HTTPCacheHandler
 }
 
 // NewMultiplexer returns a new instance of a Multiplexer.
@@ -310,9 +467,21 @@ func (m Multiplexer) Match(path string) (string, HTTPCacheHandler, error) {
 		return newPath, handler, nil
 	}
 
+	if m.fallback != nil {
+		return path, m.fallback, nil
+	}
+
 	return path, nil, fmt.Errorf("Mux not found for %s", path)
 }
 
+// WithFallback returns a copy of the Multiplexer which serves requests matching
+// none of it's Mux with the handler, such as a ProxyHandler forwarding them to
+// the network. The handler must be a HTTPHandler or HTTPCacheHandler.
+func (m Multiplexer) WithFallback(handler interface{}) Multiplexer {
+	m.fallback = NewHandleMux(handler)
+	return m
+}
+
 //================================================================================
 
 // BasicHTTPHandler defines a request interface which defines a type which will be used
@@ -1,10 +1,14 @@
 package router_test
 
 import (
+	"context"
+	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/router/cache"
 	"github.com/gu-io/gu/router/cache/memorycache"
 	"github.com/influx6/faux/tests"
 )
@@ -49,3 +53,209 @@ func TestRouter(t *testing.T) {
 	tests.Passed("Should have sucessesfully received expected response: %q", res.Status)
 
 }
+
+type cachedServer struct {
+	name     string
+	requests int
+}
+
+func (c *cachedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.requests++
+	w.Header().Set("Cache-Control", "max-age=60")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(c.name + r.URL.Path))
+}
+
+func TestRouterCacheStrategies(t *testing.T) {
+	static := &cachedServer{}
+	api := &cachedServer{}
+
+	router := router.NewRouter([]router.Mux{
+		router.NewMux("/static", static),
+		router.NewMuxWithStrategy("/api", api, cache.NetworkFirst),
+	}, memorycache.New("inmem"))
+
+	for i := 0; i < 2; i++ {
+		if _, err := router.Get("/static/app.js", nil); err != nil {
+			tests.Failed("Should have sucessesfully made request to %q", "/static/app.js")
+		}
+
+		if _, err := router.Get("/api/users", nil); err != nil {
+			tests.Failed("Should have sucessesfully made request to %q", "/api/users")
+		}
+	}
+
+	if static.requests != 1 {
+		tests.Failed("Should have served cached response for cache-first mux: %d", static.requests)
+	}
+	tests.Passed("Should have served cached response for cache-first mux")
+
+	if api.requests != 2 {
+		tests.Failed("Should have requested network-first mux for each request: %d", api.requests)
+	}
+	tests.Passed("Should have requested network-first mux for each request")
+}
+
+func TestRouterCacheNamespaces(t *testing.T) {
+	static := &cachedServer{name: "static"}
+	api := &cachedServer{name: "api"}
+
+	router := router.NewRouter([]router.Mux{
+		router.NewMux("/static", static),
+		router.NewMux("/api", api),
+	}, memorycache.New("inmem"))
+
+	for i := 0; i < 2; i++ {
+		for _, name := range []string{"static", "api"} {
+			res, err := router.Get("/"+name+"/config", nil)
+			if err != nil {
+				tests.Failed("Should have sucessesfully made request to %q", "/"+name+"/config")
+			}
+
+			body, _ := ioutil.ReadAll(res.Body)
+			if string(body) != name+"/config" {
+				tests.Failed("Should have received response of %q mux: %q", name, body)
+			}
+		}
+	}
+	tests.Passed("Should have received response of each mux for the same stripped path")
+
+	if static.requests != 1 || api.requests != 1 {
+		tests.Failed("Should have served cached responses of each mux: %d %d", static.requests, api.requests)
+	}
+	tests.Passed("Should have served cached responses of each mux")
+}
+
+func TestRouterCacheParams(t *testing.T) {
+	api := &cachedServer{name: "api"}
+
+	params := make(router.Params)
+	rx := router.NewRouter([]router.Mux{
+		router.NewMux("/api", api),
+	}, memorycache.New("inmem"))
+
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		params[name] = name
+	}
+
+	for i := 0; i < 10; i++ {
+		if _, err := rx.Get("/api/search", params); err != nil {
+			tests.Failed("Should have sucessesfully made request to %q", "/api/search")
+		}
+	}
+
+	if api.requests != 1 {
+		tests.Failed("Should have served cached response of request with params: %d", api.requests)
+	}
+	tests.Passed("Should have served cached response of request with params")
+}
+
+// blocking defines a handler which responds once released, or fails when the
+// context of the request is done.
+type blocking struct {
+	started  chan struct{}
+	release  chan struct{}
+	deadline chan bool
+}
+
+func (b blocking) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_, ok := r.Context().Deadline()
+	b.deadline <- ok
+	b.started <- struct{}{}
+
+	select {
+	case <-b.release:
+		w.WriteHeader(http.StatusOK)
+	case <-r.Context().Done():
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+}
+
+func newBlocking() blocking {
+	return blocking{
+		started:  make(chan struct{}, 10),
+		release:  make(chan struct{}),
+		deadline: make(chan bool, 10),
+	}
+}
+
+func TestRouterContext(t *testing.T) {
+	handler := newBlocking()
+	rt := router.NewRouter(handler, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-handler.started
+		cancel()
+	}()
+
+	if _, err := rt.DoContext(ctx, "GET", "/resource", nil, nil); err != context.Canceled {
+		tests.Failed("Should have returned error of cancelled context: %+q", err)
+	}
+	tests.Passed("Should have returned error of cancelled context")
+
+	if _, err := rt.GetContext(ctx, "/resource", nil); err != context.Canceled {
+		tests.Failed("Should have not made request with done context: %+q", err)
+	}
+	tests.Passed("Should have not made request with done context")
+
+	<-handler.deadline
+
+	rt.SetTimeout(20 * time.Millisecond)
+
+	if _, err := rt.Get("/resource", nil); err != context.DeadlineExceeded {
+		tests.Failed("Should have returned error of timed out request: %+q", err)
+	}
+	tests.Passed("Should have returned error of timed out request")
+
+	if !<-handler.deadline {
+		tests.Failed("Should have propagated deadline into handler")
+	}
+	tests.Passed("Should have propagated deadline into handler")
+}
+
+func TestRouterScope(t *testing.T) {
+	handler := newBlocking()
+	scope := router.NewScope()
+	rt := router.NewRouter(handler, memorycache.New("scope")).WithScope(scope)
+
+	go func() {
+		<-handler.started
+		scope.Cancel()
+	}()
+
+	if _, err := rt.Get("/resource", nil); err != context.Canceled {
+		tests.Failed("Should have cancelled request of scope: %+q", err)
+	}
+	tests.Passed("Should have cancelled request of scope")
+
+	go func() {
+		<-handler.started
+		handler.release <- struct{}{}
+	}()
+
+	res, err := rt.Get("/resource", nil)
+	if err != nil || res.StatusCode != http.StatusOK {
+		tests.Failed("Should have made request after scope was cancelled: %+q", err)
+	}
+	tests.Passed("Should have made request after scope was cancelled")
+}
+
+type panicking struct{}
+
+func (panicking) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	panic("failed handler")
+}
+
+func TestRouterHandlerPanic(t *testing.T) {
+	rt := router.NewRouter(panicking{}, nil)
+
+	defer func() {
+		if recover() != "failed handler" {
+			tests.Failed("Should have raised panic of handler in caller")
+		}
+		tests.Passed("Should have raised panic of handler in caller")
+	}()
+
+	rt.Get("/resource", nil)
+}
@@ -0,0 +1,286 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/influx6/faux/pattern"
+)
+
+var (
+	// ErrRouteNotFound is returned when no route is registered with a name.
+	ErrRouteNotFound = errors.New("Route not found")
+
+	// ErrRouteExists is returned when a route is already registered with a name.
+	ErrRouteExists = errors.New("Route already registered")
+)
+
+// Routes defines a registry of named route patterns, which are used to build
+// the urls of routes from their name and parameters, so patterns are declared
+// once and typos fail when the routes are registered and used at startup.
+type Routes struct {
+	ml     sync.RWMutex
+	routes map[string]pattern.URIMatcher
+}
+
+// NewRoutes returns a new instance of Routes.
+func NewRoutes() *Routes {
+	return &Routes{
+		routes: make(map[string]pattern.URIMatcher),
+	}
+}
+
+// routes defines the default Routes used by the package level functions.
+var routes = NewRoutes()
+
+// DefaultRoutes returns the default Routes used by the package level functions.
+func DefaultRoutes() *Routes {
+	return routes
+}
+
+// AddRoute registers the pattern by name with the default Routes.
+func AddRoute(name string, pattern string) error {
+	return routes.Add(name, pattern)
+}
+
+// MustAddRoute registers the pattern by name with the default Routes, panicking
+// if it fails.
+func MustAddRoute(name string, pattern string) {
+	routes.MustAdd(name, pattern)
+}
+
+// Route returns the pattern registered by name with the default Routes,
+// panicking if none is, so it can be given to NApp.View, NView.Component or
+// NewResolver at startup.
+func Route(name string) string {
+	return routes.MustPattern(name)
+}
+
+// URLFor returns the url of the route registered by name with the default
+// Routes, using the params.
+func URLFor(name string, params Params) (string, error) {
+	return routes.URLFor(name, params)
+}
+
+// Add registers the pattern by name, returning an error if the name is already
+// registered or the pattern is invalid, including patterns without parameters
+// which do not match their own url.
+func (r *Routes) Add(name string, pattern string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("Route name must not be empty")
+	}
+
+	matcher, err := newMatcher(pattern)
+	if err != nil {
+		return err
+	}
+
+	// Patterns without parameters must match their own url.
+	if !hasParam(matcher) {
+		if _, err := buildURL(name, matcher, nil); err != nil {
+			return err
+		}
+	}
+
+	r.ml.Lock()
+	defer r.ml.Unlock()
+
+	if _, ok := r.routes[name]; ok {
+		return ErrRouteExists
+	}
+
+	r.routes[name] = matcher
+	return nil
+}
+
+// MustAdd registers the pattern by name, panicking if it fails.
+func (r *Routes) MustAdd(name string, pattern string) {
+	if err := r.Add(name, pattern); err != nil {
+		panic(fmt.Sprintf("Route %q: %s", name, err.Error()))
+	}
+}
+
+// Pattern returns the pattern registered by name.
+func (r *Routes) Pattern(name string) (string, error) {
+	matcher, err := r.matcher(name)
+	if err != nil {
+		return "", err
+	}
+
+	return matcher.Pattern(), nil
+}
+
+// MustPattern returns the pattern registered by name, panicking if none is.
+func (r *Routes) MustPattern(name string) string {
+	pattern, err := r.Pattern(name)
+	if err != nil {
+		panic(fmt.Sprintf("Route %q: %s", name, err.Error()))
+	}
+
+	return pattern
+}
+
+// Names returns the names of the registered routes in sorted order.
+func (r *Routes) Names() []string {
+	r.ml.RLock()
+	defer r.ml.RUnlock()
+
+	names := make([]string, 0, len(r.routes))
+	for name := range r.routes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// URLFor returns the url of the route registered by name, with each parameter
+// of the pattern replaced by the value of the params with it's name. The value
+// of the "*" param is appended to patterns ending with "/*", and params which
+// are not in the pattern are added as the query of the url. An error is
+// returned if a parameter has no value or a value it's pattern does not match.
+//
+//	routes.MustAdd("user.profile", "/users/:id/profile")
+//	routes.URLFor("user.profile", Params{"id": "3", "tab": "posts"})
+//	// => /users/3/profile?tab=posts
+func (r *Routes) URLFor(name string, params Params) (string, error) {
+	matcher, err := r.matcher(name)
+	if err != nil {
+		return "", err
+	}
+
+	return buildURL(name, matcher, params)
+}
+
+// buildURL returns the url of the route with the matcher, using the params,
+// where the segments of optional parameters without a value are left out.
+func buildURL(name string, matcher pattern.URIMatcher, params Params) (string, error) {
+	used := make(map[string]bool)
+
+	var parts []string
+	for _, segment := range routeSegments(matcher) {
+		value := segment.value
+
+		if segment.param {
+			param, ok := params[value]
+			if segment.optional && param == "" {
+				used[value] = ok
+				continue
+			}
+
+			if !ok {
+				return "", fmt.Errorf("Route %q: missing param %q", name, value)
+			}
+
+			if param == "" {
+				return "", fmt.Errorf("Route %q: missing value for param %q", name, value)
+			}
+
+			if err := segment.validate(param); err != nil {
+				return "", fmt.Errorf("Route %q: %s", name, err.Error())
+			}
+
+			used[value] = true
+			value = url.PathEscape(param)
+		}
+
+		if segment.hashed {
+			value = "#" + value
+		}
+
+		parts = append(parts, value)
+	}
+
+	path := "/" + strings.Join(parts, "/")
+
+	if pattern.IsEndless(matcher.Pattern()) {
+		if rest, ok := params["*"]; ok {
+			used["*"] = true
+			path = strings.TrimSuffix(path, "/") + "/" + strings.TrimPrefix(rest, "/")
+		}
+	}
+
+	if _, _, ok := matcher.Validate(path); !ok {
+		return "", fmt.Errorf("Route %q: url %q does not match %q", name, path, matcher.Pattern())
+	}
+
+	query := make(url.Values)
+	for key, value := range params {
+		if !used[key] {
+			query.Set(key, value)
+		}
+	}
+
+	if len(query) != 0 {
+		path = path + "?" + query.Encode()
+	}
+
+	return path, nil
+}
+
+// MustURLFor returns the url of the route registered by name, panicking if it
+// fails.
+func (r *Routes) MustURLFor(name string, params Params) string {
+	path, err := r.URLFor(name, params)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return path
+}
+
+// Directive returns a PushDirectiveEvent for navigating to the url of the route
+// registered by name, using the params.
+func (r *Routes) Directive(name string, params Params) (PushDirectiveEvent, error) {
+	path, err := r.URLFor(name, params)
+	if err != nil {
+		return PushDirectiveEvent{}, err
+	}
+
+	return PushDirectiveEvent{To: path}, nil
+}
+
+// matcher returns the matcher of the route registered by name.
+func (r *Routes) matcher(name string) (pattern.URIMatcher, error) {
+	r.ml.RLock()
+	defer r.ml.RUnlock()
+
+	matcher, ok := r.routes[name]
+	if !ok {
+		return nil, ErrRouteNotFound
+	}
+
+	return matcher, nil
+}
+
+// hasParam returns true/false if the pattern of the matcher has a parameter.
+func hasParam(matcher pattern.URIMatcher) bool {
+	for _, segment := range routeSegments(matcher) {
+		if segment.param {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newMatcher returns the matcher of the pattern, returning an error if the
+// pattern is empty or contains an invalid expression.
+func newMatcher(path string) (matcher pattern.URIMatcher, err error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, errors.New("Route pattern must not be empty")
+	}
+
+	defer func() {
+		if failure := recover(); failure != nil {
+			matcher = nil
+			err = fmt.Errorf("Invalid route pattern %q: %v", path, failure)
+		}
+	}()
+
+	return URIMatcher(path), nil
+}
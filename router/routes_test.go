@@ -0,0 +1,88 @@
+package router_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/router"
+	"github.com/influx6/faux/tests"
+)
+
+func TestRoutes(t *testing.T) {
+	routes := router.NewRoutes()
+	routes.MustAdd("home", "/*")
+	routes.MustAdd("user.profile", "/users/:id/profile")
+	routes.MustAdd("post", `/posts/{id:[\d+]}`)
+	routes.MustAdd("files", "/files/*")
+
+	if err := routes.Add("home", "/home"); err != router.ErrRouteExists {
+		tests.Failed("Should have rejected registered name: %+q", err)
+	}
+	tests.Passed("Should have rejected registered name")
+
+	if err := routes.Add("broken", "/posts/{id:[(]}"); err == nil {
+		tests.Failed("Should have rejected invalid pattern")
+	}
+
+	if err := routes.Add("root", "/"); err == nil {
+		tests.Failed("Should have rejected pattern not matching it's url")
+	}
+	tests.Passed("Should have rejected invalid pattern")
+
+	for _, route := range []struct {
+		name     string
+		params   router.Params
+		expected string
+	}{
+		{"home", nil, "/"},
+		{"user.profile", router.Params{"id": "3", "tab": "posts"}, "/users/3/profile?tab=posts"},
+		{"post", router.Params{"id": "12"}, "/posts/12"},
+		{"files", router.Params{"*": "docs/readme.md"}, "/files/docs/readme.md"},
+	} {
+		path, err := routes.URLFor(route.name, route.params)
+		if err != nil || path != route.expected {
+			tests.Failed("Should have built url of route %q: %q %+q", route.name, path, err)
+		}
+	}
+	tests.Passed("Should have built urls of routes")
+
+	if _, err := routes.URLFor("user.profile", nil); err == nil {
+		tests.Failed("Should have failed to build url with missing param")
+	}
+	tests.Passed("Should have failed to build url with missing param")
+
+	if _, err := routes.URLFor("user.profile", router.Params{"id": ""}); err == nil || !strings.Contains(err.Error(), `missing value for param "id"`) {
+		tests.Failed("Should have failed to build url with empty param: %+q", err)
+	}
+	tests.Passed("Should have failed to build url with empty param")
+
+	if _, err := routes.URLFor("post", router.Params{"id": "abc"}); err == nil {
+		tests.Failed("Should have failed to build url with param not matching pattern")
+	}
+	tests.Passed("Should have failed to build url with param not matching pattern")
+
+	if _, err := routes.URLFor("user.profiel", router.Params{"id": "3"}); err != router.ErrRouteNotFound {
+		tests.Failed("Should have failed to build url of unknown route: %+q", err)
+	}
+	tests.Passed("Should have failed to build url of unknown route")
+
+	path, _ := routes.URLFor("user.profile", router.Params{"id": "3"})
+	if params, _, ok := router.NewResolver(routes.MustPattern("user.profile")).Test(path); !ok || params["id"] != "3" {
+		tests.Failed("Should have resolved built url with pattern of route: %+q", params)
+	}
+	tests.Passed("Should have resolved built url with pattern of route")
+
+	if directive, err := routes.Directive("user.profile", router.Params{"id": "4"}); err != nil || directive.To != "/users/4/profile" {
+		tests.Failed("Should have returned directive for route: %#v", directive)
+	}
+	tests.Passed("Should have returned directive for route")
+
+	defer func() {
+		if recover() == nil {
+			tests.Failed("Should have panicked for unknown route")
+		}
+		tests.Passed("Should have panicked for unknown route")
+	}()
+
+	routes.MustPattern("missing")
+}
@@ -0,0 +1,65 @@
+package router
+
+import (
+	"context"
+	"sync"
+)
+
+// Scope defines a group of requests made through a Router which are cancelled
+// together. Requests made after the Scope is cancelled belong to a new group,
+// which allows a Scope to be used across the lifetime of a view, cancelling
+// it's in-flight requests each time it is unmounted.
+type Scope struct {
+	ml     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewScope returns a new instance of a Scope.
+func NewScope() *Scope {
+	return &Scope{}
+}
+
+// Cancel cancels the in-flight requests of the Scope.
+func (s *Scope) Cancel() {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	s.ctx = nil
+	s.cancel = nil
+}
+
+// current returns the context of the current group of requests.
+func (s *Scope) current() context.Context {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	if s.ctx == nil {
+		s.ctx, s.cancel = context.WithCancel(context.Background())
+	}
+
+	return s.ctx
+}
+
+// bind returns a context derived from the giving context which is cancelled
+// when the Scope is cancelled, the returned function must be called once the
+// request is done.
+func (s *Scope) bind(ctx context.Context) (context.Context, context.CancelFunc) {
+	group := s.current()
+
+	bound, cancel := context.WithCancel(ctx)
+
+	go func() {
+		select {
+		case <-group.Done():
+			cancel()
+		case <-bound.Done():
+		}
+	}()
+
+	return bound, cancel
+}
@@ -0,0 +1,87 @@
+package trees
+
+// voidElements defines the html5 elements which can not have children and are
+// written without an end tag.
+var voidElements = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"keygen": true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
+}
+
+// IsVoidElement returns true/false if the giving tag is a html5 void element
+// (e.g br, img, input), which has no children and no end tag.
+func IsVoidElement(tag string) bool {
+	return voidElements[tag]
+}
+
+// paragraphClosers defines the elements whose start tags end an open <p>.
+var paragraphClosers = map[string]bool{
+	"address":    true,
+	"article":    true,
+	"aside":      true,
+	"blockquote": true,
+	"dd":         true,
+	"details":    true,
+	"div":        true,
+	"dl":         true,
+	"dt":         true,
+	"fieldset":   true,
+	"figcaption": true,
+	"figure":     true,
+	"footer":     true,
+	"form":       true,
+	"h1":         true,
+	"h2":         true,
+	"h3":         true,
+	"h4":         true,
+	"h5":         true,
+	"h6":         true,
+	"header":     true,
+	"hgroup":     true,
+	"hr":         true,
+	"li":         true,
+	"main":       true,
+	"menu":       true,
+	"nav":        true,
+	"ol":         true,
+	"p":          true,
+	"pre":        true,
+	"section":    true,
+	"table":      true,
+	"ul":         true,
+}
+
+// impliedEndTags defines the elements whose end tags can be omitted, with the
+// start tags of the elements which end them.
+var impliedEndTags = map[string]map[string]bool{
+	"p":        paragraphClosers,
+	"li":       {"li": true},
+	"dt":       {"dt": true, "dd": true},
+	"dd":       {"dt": true, "dd": true},
+	"option":   {"option": true, "optgroup": true},
+	"optgroup": {"optgroup": true},
+	"td":       {"td": true, "th": true, "tr": true, "tbody": true, "thead": true, "tfoot": true},
+	"th":       {"td": true, "th": true, "tr": true, "tbody": true, "thead": true, "tfoot": true},
+	"tr":       {"tr": true, "tbody": true, "thead": true, "tfoot": true},
+	"thead":    {"tbody": true, "tfoot": true},
+	"tbody":    {"tbody": true, "tfoot": true},
+}
+
+// ImpliesEndTag returns true/false if the start tag of next ends the open
+// element with the giving tag, whose end tag was omitted (e.g a <li> ends a
+// previous <li>, a <td> ends a previous <td>).
+func ImpliesEndTag(open string, next string) bool {
+	return impliedEndTags[open][next]
+}
@@ -28,7 +28,7 @@ func Space(count int) *trees.Markup {
 		spaces = append(spaces, "&nbsp;")
 	}
 
-	return trees.NewText(strings.Join(spaces, ""))
+	return trees.NewRawHTML(strings.Join(spaces, ""))
 }
 
 // Markdown takes the giving string which contains markdown written contents
@@ -66,6 +66,12 @@ func Text(content string, dl ...interface{}) *trees.Markup {
 	return trees.NewText(content, dl...)
 }
 
+// RawHTML provides a text markup containing trusted html which is written out
+// without escaping, it must never be used for content provided by users.
+func RawHTML(content string) *trees.Markup {
+	return trees.NewRawHTML(content)
+}
+
 // ParseTemplate returns the giving markup structure generated from the string
 // through the template used with the binding provided.
 func ParseTemplate(markup string, bind interface{}, ms ...trees.Appliable) *trees.Markup {
@@ -3,8 +3,10 @@ package trees
 import (
 	"fmt"
 	"strings"
+	"sync/atomic"
 
 	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/notifications"
 )
 
 // EventOptions defines a function type used to apply specific operations to a
@@ -64,6 +66,14 @@ type Event struct {
 	Tree                     *Markup
 	Remove                   common.Remover
 	secTarget                string
+
+	// Handler contains the distributor which delivers the broadcasts of the
+	// event to it's callback, it is subscribed to the notifications of the app
+	// which renders the event (see Markup.SubscribeEvents).
+	Handler notifications.EventDistributor
+
+	// dispatcher contains the notifications which the handler is subscribed to.
+	dispatcher *notifications.Notifications
 }
 
 // NewEvent returns a event object that allows registering events to eventlisteners.
@@ -80,6 +90,38 @@ func NewEvent(options ...EventOptions) *Event {
 	return evm
 }
 
+// Subscribe subscribes the handler of the event to the dispatcher, removing it
+// from the notifications it was previously subscribed to. Once the returned
+// remover is called, the event can be subscribed again.
+func (e *Event) Subscribe(dispatcher *notifications.Notifications) common.Remover {
+	if e.Remove != nil {
+		e.Remove.Remove()
+	}
+
+	remover := &eventRemover{
+		remover: dispatcher.SubscribeWithRemover(e.Handler),
+	}
+
+	e.dispatcher = dispatcher
+	e.Remove = remover
+
+	return remover
+}
+
+// Subscribed returns true if the handler of the event is subscribed to the
+// dispatcher.
+func (e *Event) Subscribed(dispatcher *notifications.Notifications) bool {
+	if e.Remove == nil || e.dispatcher != dispatcher {
+		return false
+	}
+
+	if remover, ok := e.Remove.(*eventRemover); ok {
+		return atomic.LoadInt32(&remover.removed) == 0
+	}
+
+	return true
+}
+
 // Target returns the target of the giving event.
 func (e *Event) Target() string {
 	if e.Tree != nil {
@@ -89,9 +131,29 @@ func (e *Event) Target() string {
 	return e.secTarget
 }
 
+// eventRemover defines a common.Remover which removes the subscription of a
+// event, recording it's removal as copies of the event share it.
+type eventRemover struct {
+	remover common.Remover
+	removed int32
+}
+
+// Add adds a callback to be called when Remove is called.
+func (r *eventRemover) Add(fn func()) {
+	r.remover.Add(fn)
+}
+
+// Remove implements the common.Remover.
+func (r *eventRemover) Remove() {
+	if atomic.CompareAndSwapInt32(&r.removed, 0, 1) {
+		r.remover.Remove()
+	}
+}
+
 // EventJSON defines a struct which contains the giving events and
 // and tree of the giving tree.
 type EventJSON struct {
+	EventID                  string `json:"EventID"`
 	ParentSelector           string `json:"ParentSelector"`
 	EventSelector            string `json:"EventSelector"`
 	EventName                string `json:"EventName"`
@@ -105,6 +167,7 @@ type EventJSON struct {
 // EventJSON returns the event json structure which represent the giving event.
 func (e *Event) EventJSON() EventJSON {
 	return EventJSON{
+		EventID:                  e.ID(),
 		Event:                    e.Type,
 		UseCapture:               e.UseCapture,
 		EventName:                e.EventName(),
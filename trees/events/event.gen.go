@@ -38,6 +38,7 @@ func WrapEventOnlyHandler(callback func(common.EventObject)) EventHandler {
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AbortEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -64,7 +65,8 @@ func AbortEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -75,6 +77,7 @@ func AbortEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AfterPrintEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -101,7 +104,8 @@ func AfterPrintEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -112,6 +116,7 @@ func AfterPrintEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AfterScriptExecuteEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -138,7 +143,8 @@ func AfterScriptExecuteEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -149,6 +155,7 @@ func AfterScriptExecuteEvent(callback interface{}, options ...trees.EventOptions
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AlertActiveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -175,7 +182,8 @@ func AlertActiveEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -186,6 +194,7 @@ func AlertActiveEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AlertCloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -212,7 +221,8 @@ func AlertCloseEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -223,6 +233,7 @@ func AlertCloseEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AlertingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -249,7 +260,8 @@ func AlertingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -260,6 +272,7 @@ func AlertingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AnimationEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -286,7 +299,8 @@ func AnimationEndEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -297,6 +311,7 @@ func AnimationEndEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AnimationIterationEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -323,7 +338,8 @@ func AnimationIterationEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -334,6 +350,7 @@ func AnimationIterationEvent(callback interface{}, options ...trees.EventOptions
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AnimationStartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -360,7 +377,8 @@ func AnimationStartEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -371,6 +389,7 @@ func AnimationStartEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AppinstalledEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -397,7 +416,8 @@ func AppinstalledEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -408,6 +428,7 @@ func AppinstalledEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AudioProcessEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -434,7 +455,8 @@ func AudioProcessEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -445,6 +467,7 @@ func AudioProcessEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AudioendEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -471,7 +494,8 @@ func AudioendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -482,6 +506,7 @@ func AudioendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AudiostartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -508,7 +533,8 @@ func AudiostartEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -519,6 +545,7 @@ func AudiostartEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func AuxclickEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -545,7 +572,8 @@ func AuxclickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -556,6 +584,7 @@ func AuxclickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BeforeInstallPromptEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -582,7 +611,8 @@ func BeforeInstallPromptEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -593,6 +623,7 @@ func BeforeInstallPromptEvent(callback interface{}, options ...trees.EventOption
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BeforePrintEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -619,7 +650,8 @@ func BeforePrintEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -630,6 +662,7 @@ func BeforePrintEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BeforeScriptExecuteEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -656,7 +689,8 @@ func BeforeScriptExecuteEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -667,6 +701,7 @@ func BeforeScriptExecuteEvent(callback interface{}, options ...trees.EventOption
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BeforeUnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -693,7 +728,8 @@ func BeforeUnloadEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -704,6 +740,7 @@ func BeforeUnloadEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BeginEventEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -730,7 +767,8 @@ func BeginEventEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -741,6 +779,7 @@ func BeginEventEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BlockedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -767,7 +806,8 @@ func BlockedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -778,6 +818,7 @@ func BlockedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BlurEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -804,7 +845,8 @@ func BlurEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -815,6 +857,7 @@ func BlurEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BoundaryEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -841,7 +884,8 @@ func BoundaryEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -852,6 +896,7 @@ func BoundaryEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BroadcastEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -878,7 +923,8 @@ func BroadcastEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -889,6 +935,7 @@ func BroadcastEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func BusyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -915,7 +962,8 @@ func BusyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -926,6 +974,7 @@ func BusyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CSSRuleViewCSSLinkClickedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -952,7 +1001,8 @@ func CSSRuleViewCSSLinkClickedEvent(callback interface{}, options ...trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -963,6 +1013,7 @@ func CSSRuleViewCSSLinkClickedEvent(callback interface{}, options ...trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CSSRuleViewChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -989,7 +1040,8 @@ func CSSRuleViewChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1000,6 +1052,7 @@ func CSSRuleViewChangeEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CSSRuleViewRefreshedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1026,7 +1079,8 @@ func CSSRuleViewRefreshedEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1037,6 +1091,7 @@ func CSSRuleViewRefreshedEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CachedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1063,7 +1118,8 @@ func CachedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1074,6 +1130,7 @@ func CachedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CallschangedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1100,7 +1157,8 @@ func CallschangedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1111,6 +1169,7 @@ func CallschangedEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CanPlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1137,7 +1196,8 @@ func CanPlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1148,6 +1208,7 @@ func CanPlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CanPlayThroughEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1174,7 +1235,8 @@ func CanPlayThroughEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1185,6 +1247,7 @@ func CanPlayThroughEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CardstatechangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1211,7 +1274,8 @@ func CardstatechangeEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1222,6 +1286,7 @@ func CardstatechangeEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CfstatechangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1248,7 +1313,8 @@ func CfstatechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1259,6 +1325,7 @@ func CfstatechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1285,7 +1352,8 @@ func ChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1296,6 +1364,7 @@ func ChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ChargingChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1322,7 +1391,8 @@ func ChargingChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1333,6 +1403,7 @@ func ChargingChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ChargingTimeChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1359,7 +1430,8 @@ func ChargingTimeChangeEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1370,6 +1442,7 @@ func ChargingTimeChangeEvent(callback interface{}, options ...trees.EventOptions
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CheckboxStateChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1396,7 +1469,8 @@ func CheckboxStateChangeEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1407,6 +1481,7 @@ func CheckboxStateChangeEvent(callback interface{}, options ...trees.EventOption
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CheckingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1433,7 +1508,8 @@ func CheckingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1444,6 +1520,7 @@ func CheckingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ClickEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1470,7 +1547,8 @@ func ClickEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1481,6 +1559,7 @@ func ClickEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1507,7 +1586,8 @@ func CloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1518,6 +1598,7 @@ func CloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CommandEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1544,7 +1625,8 @@ func CommandEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1555,6 +1637,7 @@ func CommandEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CommandupdateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1581,7 +1664,8 @@ func CommandupdateEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1592,6 +1676,7 @@ func CommandupdateEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CompleteEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1618,7 +1703,8 @@ func CompleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1629,6 +1715,7 @@ func CompleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CompositionEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1655,7 +1742,8 @@ func CompositionEndEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1666,6 +1754,7 @@ func CompositionEndEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CompositionStartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1692,7 +1781,8 @@ func CompositionStartEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1703,6 +1793,7 @@ func CompositionStartEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CompositionUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1729,7 +1820,8 @@ func CompositionUpdateEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1740,6 +1832,7 @@ func CompositionUpdateEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ConnectingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1766,7 +1859,8 @@ func ConnectingEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1777,6 +1871,7 @@ func ConnectingEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ConnectionInfoUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1803,7 +1898,8 @@ func ConnectionInfoUpdateEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1814,6 +1910,7 @@ func ConnectionInfoUpdateEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ContextMenuEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1840,7 +1937,8 @@ func ContextMenuEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1851,6 +1949,7 @@ func ContextMenuEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CopyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1877,7 +1976,8 @@ func CopyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1888,6 +1988,7 @@ func CopyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func CutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1914,7 +2015,8 @@ func CutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1925,6 +2027,7 @@ func CutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMAutoCompleteEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1951,7 +2054,8 @@ func DOMAutoCompleteEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1962,6 +2066,7 @@ func DOMAutoCompleteEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMContentLoadedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -1988,7 +2093,8 @@ func DOMContentLoadedEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -1999,6 +2105,7 @@ func DOMContentLoadedEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMFrameContentLoadedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2025,7 +2132,8 @@ func DOMFrameContentLoadedEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2036,6 +2144,7 @@ func DOMFrameContentLoadedEvent(callback interface{}, options ...trees.EventOpti
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMLinkAddedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2062,7 +2171,8 @@ func DOMLinkAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2073,6 +2183,7 @@ func DOMLinkAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMLinkRemovedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2099,7 +2210,8 @@ func DOMLinkRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2110,6 +2222,7 @@ func DOMLinkRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMMenuItemActiveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2136,7 +2249,8 @@ func DOMMenuItemActiveEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2147,6 +2261,7 @@ func DOMMenuItemActiveEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMMenuItemInactiveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2173,7 +2288,8 @@ func DOMMenuItemInactiveEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2184,6 +2300,7 @@ func DOMMenuItemInactiveEvent(callback interface{}, options ...trees.EventOption
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMMetaAddedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2210,7 +2327,8 @@ func DOMMetaAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2221,6 +2339,7 @@ func DOMMetaAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMMetaRemovedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2247,7 +2366,8 @@ func DOMMetaRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2258,6 +2378,7 @@ func DOMMetaRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMModalDialogClosedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2284,7 +2405,8 @@ func DOMModalDialogClosedEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2295,6 +2417,7 @@ func DOMModalDialogClosedEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMPopupBlockedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2321,7 +2444,8 @@ func DOMPopupBlockedEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2332,6 +2456,7 @@ func DOMPopupBlockedEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMTitleChangedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2358,7 +2483,8 @@ func DOMTitleChangedEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2369,6 +2495,7 @@ func DOMTitleChangedEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMWillOpenModalDialogEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2395,7 +2522,8 @@ func DOMWillOpenModalDialogEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2406,6 +2534,7 @@ func DOMWillOpenModalDialogEvent(callback interface{}, options ...trees.EventOpt
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMWindowCloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2432,7 +2561,8 @@ func DOMWindowCloseEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2443,6 +2573,7 @@ func DOMWindowCloseEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DOMWindowCreatedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2469,7 +2600,8 @@ func DOMWindowCreatedEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2480,6 +2612,7 @@ func DOMWindowCreatedEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DatachangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2506,7 +2639,8 @@ func DatachangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2517,6 +2651,7 @@ func DatachangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DataerrorEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2543,7 +2678,8 @@ func DataerrorEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2554,6 +2690,7 @@ func DataerrorEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DblClickEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2580,7 +2717,8 @@ func DblClickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2591,6 +2729,7 @@ func DblClickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DeliveredEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2617,7 +2756,8 @@ func DeliveredEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2628,6 +2768,7 @@ func DeliveredEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DeviceLightEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2654,7 +2795,8 @@ func DeviceLightEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2665,6 +2807,7 @@ func DeviceLightEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DeviceMotionEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2691,7 +2834,8 @@ func DeviceMotionEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2702,6 +2846,7 @@ func DeviceMotionEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DeviceOrientationEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2728,7 +2873,8 @@ func DeviceOrientationEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2739,6 +2885,7 @@ func DeviceOrientationEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DeviceProximityEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2765,7 +2912,8 @@ func DeviceProximityEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2776,6 +2924,7 @@ func DeviceProximityEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DevicechangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2802,7 +2951,8 @@ func DevicechangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2813,6 +2963,7 @@ func DevicechangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DialingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2839,7 +2990,8 @@ func DialingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2850,6 +3002,7 @@ func DialingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DisabledEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2876,7 +3029,8 @@ func DisabledEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2887,6 +3041,7 @@ func DisabledEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DischargingTimeChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2913,7 +3068,8 @@ func DischargingTimeChangeEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2924,6 +3080,7 @@ func DischargingTimeChangeEvent(callback interface{}, options ...trees.EventOpti
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DisconnectedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2950,7 +3107,8 @@ func DisconnectedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2961,6 +3119,7 @@ func DisconnectedEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DisconnectingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -2987,7 +3146,8 @@ func DisconnectingEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -2998,6 +3158,7 @@ func DisconnectingEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DownloadingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3024,7 +3185,8 @@ func DownloadingEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3035,6 +3197,7 @@ func DownloadingEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DragEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3061,7 +3224,8 @@ func DragEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3072,6 +3236,7 @@ func DragEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DragEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3098,7 +3263,8 @@ func DragEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3109,6 +3275,7 @@ func DragEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DragEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3135,7 +3302,8 @@ func DragEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3146,6 +3314,7 @@ func DragEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DragLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3172,7 +3341,8 @@ func DragLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3183,6 +3353,7 @@ func DragLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DragOverEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3209,7 +3380,8 @@ func DragOverEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3220,6 +3392,7 @@ func DragOverEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DragStartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3246,7 +3419,8 @@ func DragStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3257,6 +3431,7 @@ func DragStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DropEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3283,7 +3458,8 @@ func DropEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3294,6 +3470,7 @@ func DropEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func DurationChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3320,7 +3497,8 @@ func DurationChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3331,6 +3509,7 @@ func DurationChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func EmptiedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3357,7 +3536,8 @@ func EmptiedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3368,6 +3548,7 @@ func EmptiedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func EnabledEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3394,7 +3575,8 @@ func EnabledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3405,6 +3587,7 @@ func EnabledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func EndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3431,7 +3614,8 @@ func EndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3442,6 +3626,7 @@ func EndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func EndEventEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3468,7 +3653,8 @@ func EndEventEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3479,6 +3665,7 @@ func EndEventEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func EndedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3505,7 +3692,8 @@ func EndedEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3516,6 +3704,7 @@ func EndedEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func FocusEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3542,7 +3731,8 @@ func FocusEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3553,6 +3743,7 @@ func FocusEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func FocusInEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3579,7 +3770,8 @@ func FocusInEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3590,6 +3782,7 @@ func FocusInEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func FocusOutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3616,7 +3809,8 @@ func FocusOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3627,6 +3821,7 @@ func FocusOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func FullScreenChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3653,7 +3848,8 @@ func FullScreenChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3664,6 +3860,7 @@ func FullScreenChangeEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func FullScreenErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3690,7 +3887,8 @@ func FullScreenErrorEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3701,6 +3899,7 @@ func FullScreenErrorEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func FullscreenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3727,7 +3926,8 @@ func FullscreenEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3738,6 +3938,7 @@ func FullscreenEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func GamepadConnectedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3764,7 +3965,8 @@ func GamepadConnectedEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3775,6 +3977,7 @@ func GamepadConnectedEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func GamepadDisconnectedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3801,7 +4004,8 @@ func GamepadDisconnectedEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3812,6 +4016,7 @@ func GamepadDisconnectedEvent(callback interface{}, options ...trees.EventOption
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func GotpointercaptureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3838,7 +4043,8 @@ func GotpointercaptureEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3849,6 +4055,7 @@ func GotpointercaptureEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func HashChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3875,7 +4082,8 @@ func HashChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3886,6 +4094,7 @@ func HashChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func HeldEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3912,7 +4121,8 @@ func HeldEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3923,6 +4133,7 @@ func HeldEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func HoldingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3949,7 +4160,8 @@ func HoldingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3960,6 +4172,7 @@ func HoldingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func IcccardlockerrorEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -3986,7 +4199,8 @@ func IcccardlockerrorEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -3997,6 +4211,7 @@ func IcccardlockerrorEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func IccinfochangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4023,7 +4238,8 @@ func IccinfochangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4034,6 +4250,7 @@ func IccinfochangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func IncomingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4060,7 +4277,8 @@ func IncomingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4071,6 +4289,7 @@ func IncomingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func InputEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4097,7 +4316,8 @@ func InputEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4108,6 +4328,7 @@ func InputEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func InvalidEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4134,7 +4355,8 @@ func InvalidEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4145,6 +4367,7 @@ func InvalidEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func KeyDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4171,7 +4394,8 @@ func KeyDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4182,6 +4406,7 @@ func KeyDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func KeyPressEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4208,7 +4433,8 @@ func KeyPressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4219,6 +4445,7 @@ func KeyPressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func KeyUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4245,7 +4472,8 @@ func KeyUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4256,6 +4484,7 @@ func KeyUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func LanguageChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4282,7 +4511,8 @@ func LanguageChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4293,6 +4523,7 @@ func LanguageChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func LevelChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4319,7 +4550,8 @@ func LevelChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4330,6 +4562,7 @@ func LevelChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func LoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4356,7 +4589,8 @@ func LoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4367,6 +4601,7 @@ func LoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func LoadEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4393,7 +4628,8 @@ func LoadEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4404,6 +4640,7 @@ func LoadEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func LoadStartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4430,7 +4667,8 @@ func LoadStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4441,6 +4679,7 @@ func LoadStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func LoadedDataEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4467,7 +4706,8 @@ func LoadedDataEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4478,6 +4718,7 @@ func LoadedDataEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func LoadedMetadataEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4504,7 +4745,8 @@ func LoadedMetadataEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4515,6 +4757,7 @@ func LoadedMetadataEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func LocalizedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4541,7 +4784,8 @@ func LocalizedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4552,6 +4796,7 @@ func LocalizedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func LostpointercaptureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4578,7 +4823,8 @@ func LostpointercaptureEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4589,6 +4835,7 @@ func LostpointercaptureEvent(callback interface{}, options ...trees.EventOptions
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MarkEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4615,7 +4862,8 @@ func MarkEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4626,6 +4874,7 @@ func MarkEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MessageEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4652,7 +4901,8 @@ func MessageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4663,6 +4913,7 @@ func MessageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MouseDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4689,7 +4940,8 @@ func MouseDownEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4700,6 +4952,7 @@ func MouseDownEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MouseEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4726,7 +4979,8 @@ func MouseEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4737,6 +4991,7 @@ func MouseEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MouseLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4763,7 +5018,8 @@ func MouseLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4774,6 +5030,7 @@ func MouseLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MouseMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4800,7 +5057,8 @@ func MouseMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4811,6 +5069,7 @@ func MouseMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MouseOutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4837,7 +5096,8 @@ func MouseOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4848,6 +5108,7 @@ func MouseOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MouseOverEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4874,7 +5135,8 @@ func MouseOverEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4885,6 +5147,7 @@ func MouseOverEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MouseUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4911,7 +5174,8 @@ func MouseUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4922,6 +5186,7 @@ func MouseUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozAfterPaintEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4948,7 +5213,8 @@ func MozAfterPaintEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4959,6 +5225,7 @@ func MozAfterPaintEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozAudioAvailableEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -4985,7 +5252,8 @@ func MozAudioAvailableEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -4996,6 +5264,7 @@ func MozAudioAvailableEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozBeforeResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5022,7 +5291,8 @@ func MozBeforeResizeEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5033,6 +5303,7 @@ func MozBeforeResizeEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozEdgeUIGestureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5059,7 +5330,8 @@ func MozEdgeUIGestureEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5070,6 +5342,7 @@ func MozEdgeUIGestureEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozEnteredDomFullscreenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5096,7 +5369,8 @@ func MozEnteredDomFullscreenEvent(callback interface{}, options ...trees.EventOp
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5107,6 +5381,7 @@ func MozEnteredDomFullscreenEvent(callback interface{}, options ...trees.EventOp
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozGamepadButtonDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5133,7 +5408,8 @@ func MozGamepadButtonDownEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5144,6 +5420,7 @@ func MozGamepadButtonDownEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozGamepadButtonUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5170,7 +5447,8 @@ func MozGamepadButtonUpEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5181,6 +5459,7 @@ func MozGamepadButtonUpEvent(callback interface{}, options ...trees.EventOptions
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozMagnifyGestureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5207,7 +5486,8 @@ func MozMagnifyGestureEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5218,6 +5498,7 @@ func MozMagnifyGestureEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozMagnifyGestureStartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5244,7 +5525,8 @@ func MozMagnifyGestureStartEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5255,6 +5537,7 @@ func MozMagnifyGestureStartEvent(callback interface{}, options ...trees.EventOpt
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozMagnifyGestureUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5281,7 +5564,8 @@ func MozMagnifyGestureUpdateEvent(callback interface{}, options ...trees.EventOp
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5292,6 +5576,7 @@ func MozMagnifyGestureUpdateEvent(callback interface{}, options ...trees.EventOp
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozPressTapGestureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5318,7 +5603,8 @@ func MozPressTapGestureEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5329,6 +5615,7 @@ func MozPressTapGestureEvent(callback interface{}, options ...trees.EventOptions
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozRotateGestureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5355,7 +5642,8 @@ func MozRotateGestureEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5366,6 +5654,7 @@ func MozRotateGestureEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozRotateGestureStartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5392,7 +5681,8 @@ func MozRotateGestureStartEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5403,6 +5693,7 @@ func MozRotateGestureStartEvent(callback interface{}, options ...trees.EventOpti
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozRotateGestureUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5429,7 +5720,8 @@ func MozRotateGestureUpdateEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5440,6 +5732,7 @@ func MozRotateGestureUpdateEvent(callback interface{}, options ...trees.EventOpt
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozScrolledAreaChangedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5466,7 +5759,8 @@ func MozScrolledAreaChangedEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5477,6 +5771,7 @@ func MozScrolledAreaChangedEvent(callback interface{}, options ...trees.EventOpt
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozSwipeGestureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5503,7 +5798,8 @@ func MozSwipeGestureEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5514,6 +5810,7 @@ func MozSwipeGestureEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozTapGestureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5540,7 +5837,8 @@ func MozTapGestureEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5551,6 +5849,7 @@ func MozTapGestureEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowseractivitydoneEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5577,7 +5876,8 @@ func MozbrowseractivitydoneEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5588,6 +5888,7 @@ func MozbrowseractivitydoneEvent(callback interface{}, options ...trees.EventOpt
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserasyncscrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5614,7 +5915,8 @@ func MozbrowserasyncscrollEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5625,6 +5927,7 @@ func MozbrowserasyncscrollEvent(callback interface{}, options ...trees.EventOpti
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowseraudioplaybackchangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5651,7 +5954,8 @@ func MozbrowseraudioplaybackchangeEvent(callback interface{}, options ...trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5662,6 +5966,7 @@ func MozbrowseraudioplaybackchangeEvent(callback interface{}, options ...trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsercaretstatechangedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5688,7 +5993,8 @@ func MozbrowsercaretstatechangedEvent(callback interface{}, options ...trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5699,6 +6005,7 @@ func MozbrowsercaretstatechangedEvent(callback interface{}, options ...trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsercloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5725,7 +6032,8 @@ func MozbrowsercloseEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5736,6 +6044,7 @@ func MozbrowsercloseEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsercontextmenuEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5762,7 +6071,8 @@ func MozbrowsercontextmenuEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5773,6 +6083,7 @@ func MozbrowsercontextmenuEvent(callback interface{}, options ...trees.EventOpti
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserdocumentfirstpaintEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5799,7 +6110,8 @@ func MozbrowserdocumentfirstpaintEvent(callback interface{}, options ...trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5810,6 +6122,7 @@ func MozbrowserdocumentfirstpaintEvent(callback interface{}, options ...trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsererrorEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5836,7 +6149,8 @@ func MozbrowsererrorEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5847,6 +6161,7 @@ func MozbrowsererrorEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserfindchangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5873,7 +6188,8 @@ func MozbrowserfindchangeEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5884,6 +6200,7 @@ func MozbrowserfindchangeEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserfirstpaintEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5910,7 +6227,8 @@ func MozbrowserfirstpaintEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5921,6 +6239,7 @@ func MozbrowserfirstpaintEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsericonchangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5947,7 +6266,8 @@ func MozbrowsericonchangeEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5958,6 +6278,7 @@ func MozbrowsericonchangeEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserloadendEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -5984,7 +6305,8 @@ func MozbrowserloadendEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5995,6 +6317,7 @@ func MozbrowserloadendEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserloadstartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6021,7 +6344,8 @@ func MozbrowserloadstartEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6032,6 +6356,7 @@ func MozbrowserloadstartEvent(callback interface{}, options ...trees.EventOption
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserlocationchangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6058,7 +6383,8 @@ func MozbrowserlocationchangeEvent(callback interface{}, options ...trees.EventO
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6069,6 +6395,7 @@ func MozbrowserlocationchangeEvent(callback interface{}, options ...trees.EventO
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsermanifestchangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6095,7 +6422,8 @@ func MozbrowsermanifestchangeEvent(callback interface{}, options ...trees.EventO
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6106,6 +6434,7 @@ func MozbrowsermanifestchangeEvent(callback interface{}, options ...trees.EventO
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsermetachangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6132,7 +6461,8 @@ func MozbrowsermetachangeEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6143,6 +6473,7 @@ func MozbrowsermetachangeEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowseropensearchEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6169,7 +6500,8 @@ func MozbrowseropensearchEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6180,6 +6512,7 @@ func MozbrowseropensearchEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowseropentabEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6206,7 +6539,8 @@ func MozbrowseropentabEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6217,6 +6551,7 @@ func MozbrowseropentabEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowseropenwindowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6243,7 +6578,8 @@ func MozbrowseropenwindowEvent(callback interface{}, options ...trees.EventOptio
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6254,6 +6590,7 @@ func MozbrowseropenwindowEvent(callback interface{}, options ...trees.EventOptio
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserresizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6280,7 +6617,8 @@ func MozbrowserresizeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6291,6 +6629,7 @@ func MozbrowserresizeEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserscrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6317,7 +6656,8 @@ func MozbrowserscrollEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6328,6 +6668,7 @@ func MozbrowserscrollEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserscrollareachangedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6354,7 +6695,8 @@ func MozbrowserscrollareachangedEvent(callback interface{}, options ...trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6365,6 +6707,7 @@ func MozbrowserscrollareachangedEvent(callback interface{}, options ...trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserscrollviewchangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6391,7 +6734,8 @@ func MozbrowserscrollviewchangeEvent(callback interface{}, options ...trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6402,6 +6746,7 @@ func MozbrowserscrollviewchangeEvent(callback interface{}, options ...trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsersecuritychangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6428,7 +6773,8 @@ func MozbrowsersecuritychangeEvent(callback interface{}, options ...trees.EventO
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6439,6 +6785,7 @@ func MozbrowsersecuritychangeEvent(callback interface{}, options ...trees.EventO
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserselectionstatechangedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6465,7 +6812,8 @@ func MozbrowserselectionstatechangedEvent(callback interface{}, options ...trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6476,6 +6824,7 @@ func MozbrowserselectionstatechangedEvent(callback interface{}, options ...trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsershowmodalpromptEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6502,7 +6851,8 @@ func MozbrowsershowmodalpromptEvent(callback interface{}, options ...trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6513,6 +6863,7 @@ func MozbrowsershowmodalpromptEvent(callback interface{}, options ...trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowsertitlechangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6539,7 +6890,8 @@ func MozbrowsertitlechangeEvent(callback interface{}, options ...trees.EventOpti
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6550,6 +6902,7 @@ func MozbrowsertitlechangeEvent(callback interface{}, options ...trees.EventOpti
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowserusernameandpasswordrequiredEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6576,7 +6929,8 @@ func MozbrowserusernameandpasswordrequiredEvent(callback interface{}, options ..
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6587,6 +6941,7 @@ func MozbrowserusernameandpasswordrequiredEvent(callback interface{}, options ..
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MozbrowservisibilitychangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6613,7 +6968,8 @@ func MozbrowservisibilitychangeEvent(callback interface{}, options ...trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6624,6 +6980,7 @@ func MozbrowservisibilitychangeEvent(callback interface{}, options ...trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func MoztimechangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6650,7 +7007,8 @@ func MoztimechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6661,6 +7019,7 @@ func MoztimechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func NoUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6687,7 +7046,8 @@ func NoUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6698,6 +7058,7 @@ func NoUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func NomatchEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6724,7 +7085,8 @@ func NomatchEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6735,6 +7097,7 @@ func NomatchEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func NotificationclickEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6761,7 +7124,8 @@ func NotificationclickEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6772,6 +7136,7 @@ func NotificationclickEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ObsoleteEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6798,7 +7163,8 @@ func ObsoleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6809,6 +7175,7 @@ func ObsoleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func OfflineEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6835,7 +7202,8 @@ func OfflineEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6846,6 +7214,7 @@ func OfflineEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func OnconnectedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6872,7 +7241,8 @@ func OnconnectedEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6883,6 +7253,7 @@ func OnconnectedEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func OnlineEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6909,7 +7280,8 @@ func OnlineEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6920,6 +7292,7 @@ func OnlineEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func OpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6946,7 +7319,8 @@ func OpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6957,6 +7331,7 @@ func OpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func OrientationChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -6983,7 +7358,8 @@ func OrientationChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -6994,6 +7370,7 @@ func OrientationChangeEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func OverflowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7020,7 +7397,8 @@ func OverflowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7031,6 +7409,7 @@ func OverflowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PageHideEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7057,7 +7436,8 @@ func PageHideEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7068,6 +7448,7 @@ func PageHideEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PageShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7094,7 +7475,8 @@ func PageShowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7105,6 +7487,7 @@ func PageShowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PasteEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7131,7 +7514,8 @@ func PasteEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7142,6 +7526,7 @@ func PasteEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PauseEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7168,7 +7553,8 @@ func PauseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7179,6 +7565,7 @@ func PauseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7205,7 +7592,8 @@ func PlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7216,6 +7604,7 @@ func PlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PlayingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7242,7 +7631,8 @@ func PlayingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7253,6 +7643,7 @@ func PlayingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointerLockChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7279,7 +7670,8 @@ func PointerLockChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7290,6 +7682,7 @@ func PointerLockChangeEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointerLockErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7316,7 +7709,8 @@ func PointerLockErrorEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7327,6 +7721,7 @@ func PointerLockErrorEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointercancelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7353,7 +7748,8 @@ func PointercancelEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7364,6 +7760,7 @@ func PointercancelEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointerdownEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7390,7 +7787,8 @@ func PointerdownEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7401,6 +7799,7 @@ func PointerdownEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointerenterEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7427,7 +7826,8 @@ func PointerenterEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7438,6 +7838,7 @@ func PointerenterEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointerleaveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7464,7 +7865,8 @@ func PointerleaveEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7475,6 +7877,7 @@ func PointerleaveEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointermoveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7501,7 +7904,8 @@ func PointermoveEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7512,6 +7916,7 @@ func PointermoveEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointeroutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7538,7 +7943,8 @@ func PointeroutEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7549,6 +7955,7 @@ func PointeroutEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointeroverEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7575,7 +7982,8 @@ func PointeroverEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7586,6 +7994,7 @@ func PointeroverEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PointerupEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7612,7 +8021,8 @@ func PointerupEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7623,6 +8033,7 @@ func PointerupEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PopStateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7649,7 +8060,8 @@ func PopStateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7660,6 +8072,7 @@ func PopStateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PopuphiddenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7686,7 +8099,8 @@ func PopuphiddenEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7697,6 +8111,7 @@ func PopuphiddenEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PopuphidingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7723,7 +8138,8 @@ func PopuphidingEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7734,6 +8150,7 @@ func PopuphidingEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PopupshowingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7760,7 +8177,8 @@ func PopupshowingEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7771,6 +8189,7 @@ func PopupshowingEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PopupshownEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7797,7 +8216,8 @@ func PopupshownEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7808,6 +8228,7 @@ func PopupshownEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ProgressEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7834,7 +8255,8 @@ func ProgressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7845,6 +8267,7 @@ func ProgressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PushEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7871,7 +8294,8 @@ func PushEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7882,6 +8306,7 @@ func PushEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func PushsubscriptionchangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7908,7 +8333,8 @@ func PushsubscriptionchangeEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7919,6 +8345,7 @@ func PushsubscriptionchangeEvent(callback interface{}, options ...trees.EventOpt
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func RadioStateChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7945,7 +8372,8 @@ func RadioStateChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7956,6 +8384,7 @@ func RadioStateChangeEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func RateChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -7982,7 +8411,8 @@ func RateChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -7993,6 +8423,7 @@ func RateChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ReadystateChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8019,7 +8450,8 @@ func ReadystateChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8030,6 +8462,7 @@ func ReadystateChangeEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ReceivedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8056,7 +8489,8 @@ func ReceivedEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8067,6 +8501,7 @@ func ReceivedEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func RepeatEventEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8093,7 +8528,8 @@ func RepeatEventEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8104,6 +8540,7 @@ func RepeatEventEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func RequestprogressEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8130,7 +8567,8 @@ func RequestprogressEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8141,6 +8579,7 @@ func RequestprogressEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ResetEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8167,7 +8606,8 @@ func ResetEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8178,6 +8618,7 @@ func ResetEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8204,7 +8645,8 @@ func ResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8215,6 +8657,7 @@ func ResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ResourcetimingbufferfullEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8241,7 +8684,8 @@ func ResourcetimingbufferfullEvent(callback interface{}, options ...trees.EventO
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8252,6 +8696,7 @@ func ResourcetimingbufferfullEvent(callback interface{}, options ...trees.EventO
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ResponseprogressEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8278,7 +8723,8 @@ func ResponseprogressEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8289,6 +8735,7 @@ func ResponseprogressEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ResultEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8315,7 +8762,8 @@ func ResultEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8326,6 +8774,7 @@ func ResultEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ResumeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8352,7 +8801,8 @@ func ResumeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8363,6 +8813,7 @@ func ResumeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ResumingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8389,7 +8840,8 @@ func ResumingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8400,6 +8852,7 @@ func ResumingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SSTabClosingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8426,7 +8879,8 @@ func SSTabClosingEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8437,6 +8891,7 @@ func SSTabClosingEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SSTabRestoredEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8463,7 +8918,8 @@ func SSTabRestoredEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8474,6 +8930,7 @@ func SSTabRestoredEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SSTabRestoringEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8500,7 +8957,8 @@ func SSTabRestoringEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8511,6 +8969,7 @@ func SSTabRestoringEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SSWindowClosingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8537,7 +8996,8 @@ func SSWindowClosingEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8548,6 +9008,7 @@ func SSWindowClosingEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SSWindowStateBusyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8574,7 +9035,8 @@ func SSWindowStateBusyEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8585,6 +9047,7 @@ func SSWindowStateBusyEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SSWindowStateReadyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8611,7 +9074,8 @@ func SSWindowStateReadyEvent(callback interface{}, options ...trees.EventOptions
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8622,6 +9086,7 @@ func SSWindowStateReadyEvent(callback interface{}, options ...trees.EventOptions
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SVGAbortEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8648,7 +9113,8 @@ func SVGAbortEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8659,6 +9125,7 @@ func SVGAbortEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SVGErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8685,7 +9152,8 @@ func SVGErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8696,6 +9164,7 @@ func SVGErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SVGLoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8722,7 +9191,8 @@ func SVGLoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8733,6 +9203,7 @@ func SVGLoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SVGResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8759,7 +9230,8 @@ func SVGResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8770,6 +9242,7 @@ func SVGResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SVGScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8796,7 +9269,8 @@ func SVGScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8807,6 +9281,7 @@ func SVGScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SVGUnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8833,7 +9308,8 @@ func SVGUnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8844,6 +9320,7 @@ func SVGUnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SVGZoomEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8870,7 +9347,8 @@ func SVGZoomEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8881,6 +9359,7 @@ func SVGZoomEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8907,7 +9386,8 @@ func ScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8918,6 +9398,7 @@ func ScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SeekedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8944,7 +9425,8 @@ func SeekedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8955,6 +9437,7 @@ func SeekedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SeekingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -8981,7 +9464,8 @@ func SeekingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -8992,6 +9476,7 @@ func SeekingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SelectEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9018,7 +9503,8 @@ func SelectEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9029,6 +9515,7 @@ func SelectEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SelectionchangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9055,7 +9542,8 @@ func SelectionchangeEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9066,6 +9554,7 @@ func SelectionchangeEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SelectstartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9092,7 +9581,8 @@ func SelectstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9103,6 +9593,7 @@ func SelectstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SentEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9129,7 +9620,8 @@ func SentEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9140,6 +9632,7 @@ func SentEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9166,7 +9659,8 @@ func ShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9177,6 +9671,7 @@ func ShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SizemodechangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9203,7 +9698,8 @@ func SizemodechangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9214,6 +9710,7 @@ func SizemodechangeEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SmartCardInsertEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9240,7 +9737,8 @@ func SmartCardInsertEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9251,6 +9749,7 @@ func SmartCardInsertEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SmartCardRemoveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9277,7 +9776,8 @@ func SmartCardRemoveEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9288,6 +9788,7 @@ func SmartCardRemoveEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SoundendEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9314,7 +9815,8 @@ func SoundendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9325,6 +9827,7 @@ func SoundendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SoundstartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9351,7 +9854,8 @@ func SoundstartEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9362,6 +9866,7 @@ func SoundstartEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SpeechendEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9388,7 +9893,8 @@ func SpeechendEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9399,6 +9905,7 @@ func SpeechendEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SpeechstartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9425,7 +9932,8 @@ func SpeechstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9436,6 +9944,7 @@ func SpeechstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func StalledEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9462,7 +9971,8 @@ func StalledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9473,6 +9983,7 @@ func StalledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func StartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9499,7 +10010,8 @@ func StartEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9510,6 +10022,7 @@ func StartEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func StatechangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9536,7 +10049,8 @@ func StatechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9547,6 +10061,7 @@ func StatechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func StatuschangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9573,7 +10088,8 @@ func StatuschangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9584,6 +10100,7 @@ func StatuschangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func StkcommandEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9610,7 +10127,8 @@ func StkcommandEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9621,6 +10139,7 @@ func StkcommandEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func StksessionendEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9647,7 +10166,8 @@ func StksessionendEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9658,6 +10178,7 @@ func StksessionendEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func StorageEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9684,7 +10205,8 @@ func StorageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9695,6 +10217,7 @@ func StorageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SubmitEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9721,7 +10244,8 @@ func SubmitEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9732,6 +10256,7 @@ func SubmitEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SuccessEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9758,7 +10283,8 @@ func SuccessEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9769,6 +10295,7 @@ func SuccessEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func SuspendEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9795,7 +10322,8 @@ func SuspendEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9806,6 +10334,7 @@ func SuspendEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TabCloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9832,7 +10361,8 @@ func TabCloseEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9843,6 +10373,7 @@ func TabCloseEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TabHideEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9869,7 +10400,8 @@ func TabHideEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9880,6 +10412,7 @@ func TabHideEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TabOpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9906,7 +10439,8 @@ func TabOpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9917,6 +10451,7 @@ func TabOpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TabPinnedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9943,7 +10478,8 @@ func TabPinnedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9954,6 +10490,7 @@ func TabPinnedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TabSelectEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -9980,7 +10517,8 @@ func TabSelectEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -9991,6 +10529,7 @@ func TabSelectEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TabShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10017,7 +10556,8 @@ func TabShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10028,6 +10568,7 @@ func TabShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TabUnpinnedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10054,7 +10595,8 @@ func TabUnpinnedEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10065,6 +10607,7 @@ func TabUnpinnedEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TimeUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10091,7 +10634,8 @@ func TimeUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10102,6 +10646,7 @@ func TimeUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TimeoutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10128,7 +10673,8 @@ func TimeoutEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10139,6 +10685,7 @@ func TimeoutEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TouchCancelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10165,7 +10712,8 @@ func TouchCancelEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10176,6 +10724,7 @@ func TouchCancelEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TouchEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10202,7 +10751,8 @@ func TouchEndEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10213,6 +10763,7 @@ func TouchEndEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TouchEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10239,7 +10790,8 @@ func TouchEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10250,6 +10802,7 @@ func TouchEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TouchLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10276,7 +10829,8 @@ func TouchLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10287,6 +10841,7 @@ func TouchLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TouchMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10313,7 +10868,8 @@ func TouchMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10324,6 +10880,7 @@ func TouchMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TouchStartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10350,7 +10907,8 @@ func TouchStartEvent(callback interface{}, options ...trees.EventOptions) *trees
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10361,6 +10919,7 @@ func TouchStartEvent(callback interface{}, options ...trees.EventOptions) *trees
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TransitionEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10387,7 +10946,8 @@ func TransitionEndEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10398,6 +10958,7 @@ func TransitionEndEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TransitioncancelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10424,7 +10985,8 @@ func TransitioncancelEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10435,6 +10997,7 @@ func TransitioncancelEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TransitionrunEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10461,7 +11024,8 @@ func TransitionrunEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10472,6 +11036,7 @@ func TransitionrunEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func TransitionstartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10498,7 +11063,8 @@ func TransitionstartEvent(callback interface{}, options ...trees.EventOptions) *
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10509,6 +11075,7 @@ func TransitionstartEvent(callback interface{}, options ...trees.EventOptions) *
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func UnderflowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10535,7 +11102,8 @@ func UnderflowEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10546,6 +11114,7 @@ func UnderflowEvent(callback interface{}, options ...trees.EventOptions) *trees.
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func UnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10572,7 +11141,8 @@ func UnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10583,6 +11153,7 @@ func UnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func UpdateReadyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10609,7 +11180,8 @@ func UpdateReadyEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10620,6 +11192,7 @@ func UpdateReadyEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func UpgradeNeededEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10646,7 +11219,8 @@ func UpgradeNeededEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10657,6 +11231,7 @@ func UpgradeNeededEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func UserProximityEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10683,7 +11258,8 @@ func UserProximityEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10694,6 +11270,7 @@ func UserProximityEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func UssdreceivedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10720,7 +11297,8 @@ func UssdreceivedEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10731,6 +11309,7 @@ func UssdreceivedEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func ValueChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10757,7 +11336,8 @@ func ValueChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10768,6 +11348,7 @@ func ValueChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VersionChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10794,7 +11375,8 @@ func VersionChangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10805,6 +11387,7 @@ func VersionChangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VisibilityChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10831,7 +11414,8 @@ func VisibilityChangeEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10842,6 +11426,7 @@ func VisibilityChangeEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VoicechangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10868,7 +11453,8 @@ func VoicechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10879,6 +11465,7 @@ func VoicechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VoiceschangedEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10905,7 +11492,8 @@ func VoiceschangedEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10916,6 +11504,7 @@ func VoiceschangedEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VolumeChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10942,7 +11531,8 @@ func VolumeChangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10953,6 +11543,7 @@ func VolumeChangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VrdisplayactivateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -10979,7 +11570,8 @@ func VrdisplayactivateEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -10990,6 +11582,7 @@ func VrdisplayactivateEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VrdisplayblurEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -11016,7 +11609,8 @@ func VrdisplayblurEvent(callback interface{}, options ...trees.EventOptions) *tr
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -11027,6 +11621,7 @@ func VrdisplayblurEvent(callback interface{}, options ...trees.EventOptions) *tr
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VrdisplayconnectEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -11053,7 +11648,8 @@ func VrdisplayconnectEvent(callback interface{}, options ...trees.EventOptions)
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -11064,6 +11660,7 @@ func VrdisplayconnectEvent(callback interface{}, options ...trees.EventOptions)
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VrdisplaydeactivateEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -11090,7 +11687,8 @@ func VrdisplaydeactivateEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -11101,6 +11699,7 @@ func VrdisplaydeactivateEvent(callback interface{}, options ...trees.EventOption
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VrdisplaydisconnectEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -11127,7 +11726,8 @@ func VrdisplaydisconnectEvent(callback interface{}, options ...trees.EventOption
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -11138,6 +11738,7 @@ func VrdisplaydisconnectEvent(callback interface{}, options ...trees.EventOption
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VrdisplayfocusEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -11164,7 +11765,8 @@ func VrdisplayfocusEvent(callback interface{}, options ...trees.EventOptions) *t
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -11175,6 +11777,7 @@ func VrdisplayfocusEvent(callback interface{}, options ...trees.EventOptions) *t
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func VrdisplaypresentchangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -11201,7 +11804,8 @@ func VrdisplaypresentchangeEvent(callback interface{}, options ...trees.EventOpt
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -11212,6 +11816,7 @@ func VrdisplaypresentchangeEvent(callback interface{}, options ...trees.EventOpt
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func WaitingEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -11238,7 +11843,8 @@ func WaitingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -11249,6 +11855,7 @@ func WaitingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func WheelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -11275,7 +11882,8 @@ func WheelEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
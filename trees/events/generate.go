@@ -219,6 +219,7 @@ func WrapEventOnlyHandler(callback func(common.EventObject)) EventHandler {
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
+// The handler of the event is subscribed to the default notifications, until the app which renders it subscribes it to it's own.
 func %sEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
@@ -245,7 +246,8 @@ func %sEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 		handler(evm.Event, ev.Tree)
 	})
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = eventHandler
+	ev.Subscribe(notifications.Default())
 
 	return ev
 }
@@ -5,6 +5,8 @@ import (
 	"html/template"
 	"strings"
 
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/notifications"
 	"github.com/gu-io/gu/trees/css"
 	"github.com/russross/blackfriday"
 )
@@ -13,6 +15,7 @@ import (
 type Markup struct {
 	ID              string
 	removed         bool
+	rawHTML         bool
 	autoclose       bool
 	allowEvents     bool
 	allowChildren   bool
@@ -20,6 +23,7 @@ type Markup struct {
 	allowAttributes bool
 
 	uid           string
+	key           string
 	hash          string
 	tagname       string
 	textContent   string
@@ -51,6 +55,15 @@ func NewText(txt string, dl ...interface{}) *Markup {
 	return em
 }
 
+// NewRawHTML returns a new Text instance element whose content is trusted
+// html which is written out as is, without escaping. It must never be used for
+// content provided by users.
+func NewRawHTML(html string) *Markup {
+	em := NewText("%s", html)
+	em.rawHTML = true
+	return em
+}
+
 // MarkdownTemplate returns a markup generated from a markup down string
 // which is built into a markup. If an error occured, it will be turned into
 // an error tag with the contents of the error.
@@ -110,8 +123,11 @@ func CSSStylesheet(styles interface{}, bind interface{}, ext *css.Rule, plain bo
 //==============================================================================
 
 // NewMarkup returns a new element instance giving the specified name which is
-// used as a tag name.
+// used as a tag name. Html5 void elements (e.g br, img, input) are always auto
+// closed.
 func NewMarkup(tag string, autoClose bool) *Markup {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+
 	return &Markup{
 		allowChildren:   true,
 		allowStyles:     true,
@@ -119,8 +135,8 @@ func NewMarkup(tag string, autoClose bool) *Markup {
 		allowEvents:     true,
 		uid:             RandString(8),
 		hash:            RandString(10),
-		autoclose:       autoClose,
-		tagname:         strings.ToLower(strings.TrimSpace(tag)),
+		autoclose:       autoClose || IsVoidElement(tag),
+		tagname:         tag,
 		attrs:           []Property{NewAttr("data-gen", "gu")},
 	}
 }
@@ -267,6 +283,29 @@ func (e *Markup) EachEvent(fn func(*Event, *Markup)) {
 	}
 }
 
+// SubscribeEvents subscribes the handlers of all events of the markup and it's
+// children which are not yet subscribed to the dispatcher, returning the
+// removers of the subscribed events. Events subscribed to other notifications
+// (e.g the default notifications) are moved to the dispatcher.
+func (e *Markup) SubscribeEvents(dispatcher *notifications.Notifications) []common.Remover {
+	var removers []common.Remover
+
+	for index := range e.events {
+		ev := &e.events[index]
+		if ev.Handler == nil || ev.Subscribed(dispatcher) {
+			continue
+		}
+
+		removers = append(removers, ev.Subscribe(dispatcher))
+	}
+
+	for _, ch := range e.children {
+		removers = append(removers, ch.SubscribeEvents(dispatcher)...)
+	}
+
+	return removers
+}
+
 // Events return the elements events
 func (e *Markup) Events() []Event {
 	return e.events
@@ -314,6 +353,27 @@ func (e *Markup) Hash() string {
 	return e.hash
 }
 
+// Key returns the key used to identify the markup amongst its siblings during
+// reconciliation. If no key was set, the value of a `key` attribute is used.
+func (e *Markup) Key() string {
+	if e.key != "" {
+		return e.key
+	}
+
+	if attr, err := GetAttr(e, "key"); err == nil {
+		_, val := attr.Render()
+		return val
+	}
+
+	return ""
+}
+
+// SetKey sets the key used to identify the markup amongst its siblings during
+// reconciliation.
+func (e *Markup) SetKey(key string) {
+	e.key = key
+}
+
 //==============================================================================
 
 // Morphers exposes a method to allow adding morphers.
@@ -366,16 +426,26 @@ func (e *Markup) TextContent() string {
 	return e.textContent
 }
 
+// RawHTML returns true/false if the text content of the markup is trusted html
+// which is written out without escaping.
+func (e *Markup) RawHTML() bool {
+	return e.rawHTML
+}
+
 // Clean cleans out all internal markup marked as removable.
 func (e *Markup) Clean() {
-	for n, elm := range e.children {
+	kept := e.children[:0]
+
+	for _, elm := range e.children {
 		if elm.Removed() {
-			copy(e.children[n:], e.children[n+1:])
-			e.children = e.children[:len(e.children)-1]
-		} else {
-			elm.Clean()
+			continue
 		}
+
+		elm.Clean()
+		kept = append(kept, elm)
 	}
+
+	e.children = kept
 }
 
 // Remove sets the markup as removable and adds a 'NodeRemoved' attribute to it.
@@ -427,16 +497,19 @@ func (e *Markup) UpdateHash() {
 // Reconcile takes a old markup and reconciles its uid and its children with
 // these information,it returns a true/false telling the parent if the children
 // swapped hashes.
-// The reconcilation uses the order in which elements are added, if the order
-// and element types are same then the uid are swapped, else it firsts checks the
-// element type, but if not the same adds the old one into the new list as removed
-// then continues the check. The system takes position of elements in the old and
-// new as very important and I cant stress this enough, "Element Positioning" in
-// the markup are very important, If a Anchor was the first element in the old
-// render and the next pass returns a Div in the position for that Anchor in the
-// new render, the old Anchor will be marked as removed and will be removed from
-// the dom and ignored by the writers.
-// When two elements position are same and their types are the same then a checkup
+// The reconcilation first matches children which have a key (see Markup.Key)
+// against the old children with the same key, regardless of their position,
+// which allows lists to be re-ordered, prepended or trimmed without marking
+// every sibling as changed. Children without a key are reconciled using the
+// order in which they are added, if the order and element types are same then
+// the uid are swapped, else the old one is added into the new list as removed.
+// The system takes position of unkeyed elements in the old and new as very
+// important and I cant stress this enough, "Element Positioning" in the markup
+// are very important, If a Anchor was the first element in the old render and
+// the next pass returns a Div in the position for that Anchor in the new render,
+// the old Anchor will be marked as removed and will be removed from the dom and
+// ignored by the writers.
+// When two elements are matched and their types are the same then a checkup
 // process is done using the elements attributes, this is done to determine if the
 // hash value of the new should be swapped with the old. We cant use style properties
 // here because they are the most volatile of the set and will periodically be
@@ -474,61 +547,106 @@ func (e *Markup) Reconcile(em *Markup) bool {
 		return true
 	}
 
+	equalAttr := EqualAttributes(e, em)
+	equalStyle := EqualStyles(e, em)
+
+	childChanged := e.reconcileChildren(em.Children())
+
+	if !childChanged && equalAttr && equalStyle {
+		e.SwapHash(oldHash)
+		return false
+	}
+
+	return true
+}
+
+// reconcileChildren matches the children of the markup against the provided
+// old children, keyed children are matched by key and unkeyed children by their
+// position amongst other unkeyed children. Old children left unmatched are added
+// into the markup as removed. It returns true if any child was inserted, removed,
+// moved or changed.
+func (e *Markup) reconcileChildren(oldChildren []*Markup) bool {
 	newChildren := e.Children()
-	oldChildren := em.Children()
 
-	maxSize := len(newChildren)
-	oldMaxSize := len(oldChildren)
+	var unkeyed []*Markup
+	keyed := make(map[string]*Markup)
+	positions := make(map[*Markup]int)
 
-	equalAttr := EqualAttributes(e, em)
-	equalStyle := EqualStyles(e, em)
+	for index, och := range oldChildren {
+		positions[och] = index
 
-	// if the element had no children too, swap hash.
-	if maxSize == 0 {
-		if oldMaxSize > 0 {
-			return true
+		key := och.Key()
+		if key == "" {
+			unkeyed = append(unkeyed, och)
+			continue
 		}
 
-		if !equalAttr && !equalStyle {
-			e.SwapHash(oldHash)
-			return false
+		if _, ok := keyed[key]; !ok {
+			keyed[key] = och
 		}
-
-		return true
 	}
 
-	var childChanged bool
+	var changed bool
+	var nextUnkeyed int
+	var lastPosition = -1
 
-	for n, och := range oldChildren {
-		if maxSize > n {
+	used := make(map[*Markup]bool)
 
-			nch := newChildren[n]
-			if nch.Name() != och.Name() {
+	for _, nch := range newChildren {
+		// Removed children are left overs from a previous reconciliation.
+		if nch.Removed() {
+			continue
+		}
 
-				och.Remove()
-				e.AddChild(och)
-				childChanged = true
-				continue
-			}
+		var och *Markup
 
-			if nch.Reconcile(och) {
-				childChanged = true
+		if key := nch.Key(); key != "" {
+			if found, ok := keyed[key]; ok && !used[found] {
+				och = found
 			}
+		} else if nextUnkeyed < len(unkeyed) {
+			och = unkeyed[nextUnkeyed]
+			nextUnkeyed++
+		}
 
+		// A new child without a old counterpart is an insert.
+		if och == nil {
+			changed = true
 			continue
 		}
 
-		och.Remove()
-		e.AddChild(och)
-		childChanged = true
+		// Different element types cant reconcile, the old one will be removed.
+		if och.Name() != nch.Name() {
+			changed = true
+			continue
+		}
+
+		used[och] = true
+
+		// If the old child appears before an already matched sibling then it
+		// was moved.
+		if position := positions[och]; position < lastPosition {
+			changed = true
+		} else {
+			lastPosition = position
+		}
+
+		if nch.Reconcile(och) {
+			changed = true
+		}
 	}
 
-	if !childChanged && equalAttr && equalStyle {
-		e.SwapHash(oldHash)
-		return false
+	for _, och := range oldChildren {
+		if used[och] {
+			continue
+		}
+
+		och.Remove()
+		e.AddChild(och)
+		changed = true
 	}
 
-	return true
+	return changed
 }
 
 // FirstChild returns the first child in the markup children list.
@@ -568,6 +686,26 @@ func (e *Markup) AddChild(child ...*Markup) {
 	}
 }
 
+// RemoveChild removes the child from the children of the markup, returning
+// false if it is not a child of the markup.
+func (e *Markup) RemoveChild(child *Markup) bool {
+	for index, ch := range e.children {
+		if ch != child {
+			continue
+		}
+
+		e.children = append(e.children[:index], e.children[index+1:]...)
+
+		if child.parent == e {
+			child.parent = nil
+		}
+
+		return true
+	}
+
+	return false
+}
+
 // EachChild iterates all children from this giving root down with all childrens
 // allowing the callback to process the child has needed.
 func (e *Markup) EachChild(fn func(*Markup)) {
@@ -710,6 +848,7 @@ func (e *Markup) CopyTo(co *Markup) {
 	// if co.textContent == "" {
 	co.textContent = e.textContent
 	co.textContentFn = e.textContentFn
+	co.rawHTML = e.rawHTML
 	// }
 
 	//clone the internal styles
@@ -754,7 +893,9 @@ func (e *Markup) Clone() *Markup {
 	//copy over the textContent
 	co.textContent = e.textContent
 	co.textContentFn = e.textContentFn
+	co.rawHTML = e.rawHTML
 	co.ID = e.ID
+	co.key = e.key
 	co.hash = e.hash
 	co.uid = e.uid
 
@@ -764,7 +905,7 @@ func (e *Markup) Clone() *Markup {
 	co.allowAttributes = e.allowAttributes
 
 	if e.Removed() {
-		co.Removed()
+		co.removed = true
 	}
 
 	//clone the internal styles
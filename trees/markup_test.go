@@ -0,0 +1,171 @@
+package trees_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/events"
+)
+
+func makeList(keys ...string) *trees.Markup {
+	list := trees.NewMarkup("ul", false)
+
+	for _, key := range keys {
+		item := trees.NewMarkup("li", false)
+		trees.Key(key).Apply(item)
+		trees.NewText("%s", key).Apply(item)
+		item.Apply(list)
+	}
+
+	return list
+}
+
+func TestKeyedReconcile(t *testing.T) {
+	old := makeList("b", "c", "d")
+
+	oldUIDs := make(map[string]string)
+	oldHashes := make(map[string]string)
+	for _, item := range old.Children() {
+		oldUIDs[item.Key()] = item.UID()
+		oldHashes[item.Key()] = item.Hash()
+	}
+
+	// Reconcile once to have the text nodes share hashes with the old.
+	stable := makeList("b", "c", "d")
+	if stable.Reconcile(old) {
+		t.Fatalf("\t%s\t Should have reconciled identical lists as unchanged", failed)
+	}
+	t.Logf("\t%s\t Should have reconciled identical lists as unchanged", success)
+
+	updated := makeList("a", "b", "c", "d")
+	if !updated.Reconcile(stable) {
+		t.Fatalf("\t%s\t Should have reconciled prepended list as changed", failed)
+	}
+	t.Logf("\t%s\t Should have reconciled prepended list as changed", success)
+
+	children := updated.Children()
+	if len(children) != 4 {
+		t.Fatalf("\t%s\t Should have no removed children appended: %d", failed, len(children))
+	}
+	t.Logf("\t%s\t Should have no removed children appended", success)
+
+	for _, item := range children[1:] {
+		if item.UID() != oldUIDs[item.Key()] {
+			t.Fatalf("\t%s\t Should have matched %q by key with its old uid", failed, item.Key())
+		}
+
+		if item.Hash() != oldHashes[item.Key()] {
+			t.Fatalf("\t%s\t Should have kept hash for unchanged %q", failed, item.Key())
+		}
+	}
+	t.Logf("\t%s\t Should have matched children by key keeping uid and hash", success)
+}
+
+func TestKeyedReconcileMoveAndRemove(t *testing.T) {
+	old := makeList("a", "b", "c")
+	oldC := old.Children()[2]
+
+	updated := makeList("c", "a")
+	if !updated.Reconcile(old) {
+		t.Fatalf("\t%s\t Should have reconciled re-ordered list as changed", failed)
+	}
+	t.Logf("\t%s\t Should have reconciled re-ordered list as changed", success)
+
+	children := updated.Children()
+	if len(children) != 3 {
+		t.Fatalf("\t%s\t Should have appended removed child: %d", failed, len(children))
+	}
+	t.Logf("\t%s\t Should have appended removed child", success)
+
+	if children[0].UID() != oldC.UID() {
+		t.Fatalf("\t%s\t Should have matched moved child by key", failed)
+	}
+	t.Logf("\t%s\t Should have matched moved child by key", success)
+
+	if !children[2].Removed() || children[2].Key() != "b" {
+		t.Fatalf("\t%s\t Should have marked child with key %q as removed", failed, "b")
+	}
+	t.Logf("\t%s\t Should have marked child with key %q as removed", success, "b")
+}
+
+func TestRemoveChild(t *testing.T) {
+	list := makeList("a", "b", "c")
+	second := list.Children()[1]
+
+	if !list.RemoveChild(second) {
+		t.Fatalf("\t%s\t Should have removed child", failed)
+	}
+	t.Logf("\t%s\t Should have removed child", success)
+
+	if len(list.Children()) != 2 || list.Children()[1].Key() != "c" {
+		t.Fatalf("\t%s\t Should have kept order of other children: %d", failed, len(list.Children()))
+	}
+	t.Logf("\t%s\t Should have kept order of other children", success)
+
+	if list.RemoveChild(second) {
+		t.Fatalf("\t%s\t Should have failed to remove markup which is not a child", failed)
+	}
+	t.Logf("\t%s\t Should have failed to remove markup which is not a child", success)
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	var clicks int
+
+	button := trees.NewMarkup("button", false)
+	click := events.ClickEvent(func() { clicks++ })
+	click.Apply(button)
+
+	defer click.Remove.Remove()
+
+	broadcast := common.EventBroadcast{EventName: "ClickEvent", EventID: click.ID()}
+
+	notifications.Dispatch(broadcast)
+
+	if clicks != 1 {
+		t.Fatalf("\t%s\t Should have successfully delivered event through default notifications: %d", failed, clicks)
+	}
+	t.Logf("\t%s\t Should have successfully delivered event through default notifications", success)
+
+	dispatcher := notifications.New()
+	removers := button.SubscribeEvents(dispatcher)
+
+	defer func() {
+		for _, remover := range removers {
+			remover.Remove()
+		}
+	}()
+
+	notifications.Dispatch(broadcast)
+	dispatcher.Dispatch(broadcast)
+
+	if clicks != 2 {
+		t.Fatalf("\t%s\t Should have successfully moved event to app notifications: %d", failed, clicks)
+	}
+	t.Logf("\t%s\t Should have successfully moved event to app notifications", success)
+
+	if len(button.SubscribeEvents(dispatcher)) != 0 {
+		t.Fatalf("\t%s\t Should have successfully skipped events subscribed to notifications", failed)
+	}
+	t.Logf("\t%s\t Should have successfully skipped events subscribed to notifications", success)
+
+	for _, remover := range removers {
+		remover.Remove()
+	}
+
+	dispatcher.Dispatch(broadcast)
+
+	if clicks != 2 {
+		t.Fatalf("\t%s\t Should have successfully removed event from app notifications: %d", failed, clicks)
+	}
+	t.Logf("\t%s\t Should have successfully removed event from app notifications", success)
+
+	removers = button.SubscribeEvents(dispatcher)
+	dispatcher.Dispatch(broadcast)
+
+	if len(removers) != 1 || clicks != 3 {
+		t.Fatalf("\t%s\t Should have successfully resubscribed released event: %d", failed, clicks)
+	}
+	t.Logf("\t%s\t Should have successfully resubscribed released event", success)
+}
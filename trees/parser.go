@@ -98,10 +98,6 @@ func ParseAsRoot(root string, markup string) *Markup {
 	return rootElem
 }
 
-type counter interface {
-	Next() int
-}
-
 type cn struct {
 	ml  sync.Mutex
 	val int
@@ -126,9 +122,16 @@ func ParseTree(markup string) []*Markup {
 	return rootElem.Children()
 }
 
+// pullNode adds the nodes read from the tokens into the root. It keeps a stack
+// of the open elements, so void elements (e.g <br>, <img>) never take children,
+// omitted end tags (e.g <li>, <p>, <td>) are implied by the start tags which
+// end them and end tags close all open elements within the element they end.
 func pullNode(tokens *html.Tokenizer, root *Markup) {
+	open := []*Markup{root}
+
 	for {
 		token := tokens.Next()
+		current := open[len(open)-1]
 
 		switch token {
 		case html.ErrorToken:
@@ -142,28 +145,32 @@ func pullNode(tokens *html.Tokenizer, root *Markup) {
 			}
 
 			if token == html.CommentToken {
-				text = "<!--" + text + "-->"
+				NewRawHTML("<!--" + text + "-->").Apply(current)
+				continue
 			}
 
-			// if node != nil {
-			// 	NewText(text).Apply(node)
-			// 	continue
-			// }
-
-			NewText(text).Apply(root)
+			NewText("%s", text).Apply(current)
 			continue
 
-		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
-			tagName, hasAttr := tokens.TagName()
+		case html.EndTagToken:
+			tagName, _ := tokens.TagName()
 
-			// fmt.Printf("Token: %#v -> %+q -> %q -> %t\n", token, token, tagName, token == html.SelfClosingTagToken)
+			// The root is never closed, so unmatched end tags are ignored.
+			index := lastOpen(len(open), func(index int) string { return open[index].tagname }, string(tagName))
+			if index > 0 {
+				open = open[:index]
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tagName, hasAttr := tokens.TagName()
+			name := string(tagName)
 
-			if token == html.EndTagToken && string(tagName) == root.tagname {
-				return
+			for len(open) > 1 && ImpliesEndTag(open[len(open)-1].tagname, name) {
+				open = open[:len(open)-1]
 			}
 
-			node := NewMarkup(string(tagName), token == html.SelfClosingTagToken)
-			node.Apply(root)
+			node := NewMarkup(name, token == html.SelfClosingTagToken)
+			node.Apply(open[len(open)-1])
 
 			if hasAttr {
 			attrLoop:
@@ -180,13 +187,26 @@ func pullNode(tokens *html.Tokenizer, root *Markup) {
 				}
 			}
 
-			if token == html.SelfClosingTagToken {
+			if node.AutoClosed() {
 				continue
 			}
 
-			pullNode(tokens, node)
+			open = append(open, node)
+		}
+	}
+}
+
+// lastOpen returns the index of the last open element with the giving tag
+// within the total open elements, where the tag of each is returned by the
+// tagAt function. It returns -1 if no open element matches.
+func lastOpen(total int, tagAt func(int) string, tag string) int {
+	for index := total - 1; index >= 0; index-- {
+		if tagAt(index) == tag {
+			return index
 		}
 	}
+
+	return -1
 }
 
 // ParseTreeToText takes a string markup and returns a *Markup which
@@ -203,9 +223,38 @@ func ParseTreeToText(markup string, withReturns bool) (io.WriterTo, error) {
 
 	writeText(&buffer, "%s := trees.NewMarkup(%q, %t)", rootName, "div", false)
 
+	// open contains the open elements as pairs of element variable and tag names.
+	open := [][2]string{{rootName, ""}}
+
 	for c := tokenizer.Next(); c != html.ErrorToken; c = tokenizer.Next() {
 		node := tokenizer.Token()
-		traverseNode(&buffer, tokenizer, node, nameCounter, rootName)
+		tagName := strings.TrimSpace(node.Data)
+
+		switch node.Type {
+		case html.EndTagToken:
+			index := lastOpen(len(open), func(index int) string { return open[index][1] }, tagName)
+			if index > 0 {
+				open = open[:index]
+			}
+
+			continue
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			for len(open) > 1 && ImpliesEndTag(open[len(open)-1][1], tagName) {
+				open = open[:len(open)-1]
+			}
+		}
+
+		if tagName == "" {
+			continue
+		}
+
+		elementName := fmt.Sprintf("elem%d", nameCounter.Next())
+		writeNode(&buffer, node, open[len(open)-1][0], elementName)
+
+		if node.Type == html.StartTagToken && !IsVoidElement(tagName) {
+			open = append(open, [2]string{elementName, tagName})
+		}
 	}
 
 	if withReturns {
@@ -221,52 +270,17 @@ func ParseTreeToText(markup string, withReturns bool) (io.WriterTo, error) {
 	return &buffer, nil
 }
 
-func traverseNode(w io.Writer, tokens *html.Tokenizer, pnode html.Token, count counter, parent string) {
-	tagName := strings.TrimSpace(pnode.Data)
-	if tagName == "" {
-		return
-	}
-
-	elementName := fmt.Sprintf("elem%d", count.Next())
-	writeNode(w, pnode, parent, elementName)
-
-	for c := tokens.Next(); c != html.ErrorToken; c = tokens.Next() {
-		node := tokens.Token()
-		ntagName := strings.TrimSpace(node.Data)
-
-		if ntagName == "" {
-			continue
-		}
-
-		if node.Type == html.SelfClosingTagToken && tagName == ntagName {
-			return
-		}
-
-		if node.Type == html.EndTagToken && tagName == ntagName {
-			return
-		}
-
-		if node.Type == html.StartTagToken {
-			traverseNode(w, tokens, node, count, elementName)
-			continue
-		}
-
-		nelementName := fmt.Sprintf("elem%d", count.Next())
-		writeNode(w, node, elementName, nelementName)
-	}
-}
-
 func writeNode(w io.Writer, node html.Token, parent string, elementName string) {
 	switch node.Type {
 	case html.CommentToken:
-		writeText(w, "trees.NewText(\"<---%s --->\").Apply(%s)", node.Data, parent)
+		writeText(w, "trees.NewRawHTML(%+q).Apply(%s)", "<!--"+node.Data+"-->", parent)
 		return
 	case html.StartTagToken, html.SelfClosingTagToken:
-		writeText(w, "%s := trees.NewMarkup(%q, %t)\n%s.Apply(%s)", elementName, node.Data, node.Type == html.SelfClosingTagToken, elementName, parent)
+		writeText(w, "%s := trees.NewMarkup(%q, %t)\n%s.Apply(%s)", elementName, node.Data, node.Type == html.SelfClosingTagToken || IsVoidElement(node.Data), elementName, parent)
 
 		for _, attr := range node.Attr {
 			if attr.Namespace != "" {
-				writeText(w, "trees.NewAttr(\"%s:%s\", %q).Apply(%s)", attr.Namespace, attr.Key, attr.Val)
+				writeText(w, "trees.NewAttr(\"%s:%s\", %q).Apply(%s)", attr.Namespace, attr.Key, attr.Val, elementName)
 				continue
 			}
 
@@ -106,3 +106,98 @@ func TestParser(t *testing.T) {
 
 	t.Logf("\t%s\t Parser should have produced markup for html: %q", success, strings.Join(html, ""))
 }
+
+func TestParserVoidElements(t *testing.T) {
+	trees.SetMode(trees.Pretty)
+	defer trees.SetMode(trees.Normal)
+
+	result := trees.ParseTree(`<div><img src="./gu.png"><br><input type="text"><span>Name</span></div>`)
+	if len(result) != 1 {
+		t.Fatalf("\t%s\t Should have produced a single root: %d", failed, len(result))
+	}
+	t.Logf("\t%s\t Should have produced a single root", success)
+
+	if structure := markupStructure(result[0]); structure != "div(img,br,input,span)" {
+		t.Fatalf("\t%s\t Should have kept siblings of void elements: %q", failed, structure)
+	}
+	t.Logf("\t%s\t Should have kept siblings of void elements", success)
+
+	if html := result[0].HTML(); !strings.Contains(html, `<br data-gen="gu" style=""/>`) || strings.Contains(html, "</br>") {
+		t.Fatalf("\t%s\t Should have written void elements without end tags: %q", failed, html)
+	}
+	t.Logf("\t%s\t Should have written void elements without end tags", success)
+}
+
+func TestParserImpliedEndTags(t *testing.T) {
+	result := trees.ParseTree(`
+		<ul><li>One<li>Two<li>Three</ul>
+		<p>First<p>Second<div>Block</div>
+		<table><tr><td>A<td>B<tr><td>C</table>
+	`)
+
+	var structure []string
+	for _, res := range result {
+		structure = append(structure, markupStructure(res))
+	}
+
+	expected := "ul(li,li,li) p p div table(tr(td,td),tr(td))"
+	if strings.Join(structure, " ") != expected {
+		t.Fatalf("\t%s\t Should have implied omitted end tags: %q", failed, strings.Join(structure, " "))
+	}
+	t.Logf("\t%s\t Should have implied omitted end tags", success)
+}
+
+func TestParserUnmatchedEndTags(t *testing.T) {
+	result := trees.ParseTree(`<div></div></div><p>x</p></span>`)
+
+	var structure []string
+	for _, res := range result {
+		structure = append(structure, markupStructure(res))
+	}
+
+	if strings.Join(structure, " ") != "div p" {
+		t.Fatalf("\t%s\t Should have ignored unmatched end tags: %q", failed, strings.Join(structure, " "))
+	}
+	t.Logf("\t%s\t Should have ignored unmatched end tags", success)
+
+	if html := result[1].HTML(); !strings.Contains(html, ">x</p>") {
+		t.Fatalf("\t%s\t Should have kept text after unmatched end tags: %q", failed, html)
+	}
+	t.Logf("\t%s\t Should have kept text after unmatched end tags", success)
+}
+
+// markupStructure returns the tag names of the element and its children, leaving
+// out text.
+func markupStructure(m *trees.Markup) string {
+	var children []string
+
+	for _, child := range m.Children() {
+		if child.Name() != "text" {
+			children = append(children, markupStructure(child))
+		}
+	}
+
+	if len(children) == 0 {
+		return m.Name()
+	}
+
+	return m.Name() + "(" + strings.Join(children, ",") + ")"
+}
+
+func TestParserToTextVoidElements(t *testing.T) {
+	expected := "root := trees.NewMarkup(\"div\", false)\nelem1 := trees.NewMarkup(\"ul\", false)\nelem1.Apply(root)\nelem2 := trees.NewMarkup(\"li\", false)\nelem2.Apply(elem1)\nelem3 := trees.NewMarkup(\"br\", true)\nelem3.Apply(elem2)\nelem4 := trees.NewMarkup(\"li\", false)\nelem4.Apply(elem1)\ntrees.NewText(\"Two\").Apply(elem4)\n"
+
+	wl, err := trees.ParseTreeToText(`<ul><li><br><li>Two</ul>`, false)
+	if err != nil {
+		t.Fatalf("\t%s\t Should have created markup snippet: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have created markup snippet", success)
+
+	var content bytes.Buffer
+	wl.WriteTo(&content)
+
+	if content.String() != expected {
+		t.Fatalf("\t%s\t Should have created void and implied elements: %q", failed, content.String())
+	}
+	t.Logf("\t%s\t Should have created void and implied elements", success)
+}
@@ -0,0 +1,261 @@
+package trees
+
+// PatchOp defines the operation which a Patch applies to the DOM.
+type PatchOp string
+
+// contains the set of operations supported by a Patch.
+const (
+	// InsertNode inserts the Patch.Markup into the Target at Patch.Index.
+	InsertNode PatchOp = "InsertNode"
+
+	// RemoveNode removes the Target from the DOM.
+	RemoveNode PatchOp = "RemoveNode"
+
+	// MoveNode moves the Target to Patch.Index within it's parent.
+	MoveNode PatchOp = "MoveNode"
+
+	// ReplaceNode replaces the Target with the Patch.Markup.
+	ReplaceNode PatchOp = "ReplaceNode"
+
+	// SetAttribute sets the attribute Patch.Name of the Target to Patch.Value.
+	SetAttribute PatchOp = "SetAttribute"
+
+	// RemoveAttribute removes the attribute Patch.Name from the Target.
+	RemoveAttribute PatchOp = "RemoveAttribute"
+
+	// SetStyle sets the style property Patch.Name of the Target to Patch.Value.
+	SetStyle PatchOp = "SetStyle"
+
+	// RemoveStyle removes the style property Patch.Name from the Target.
+	RemoveStyle PatchOp = "RemoveStyle"
+
+	// SetText sets the text of the child node at Patch.Index of the Target to
+	// Patch.Value, if Patch.Index is -1 then the text of the Target is set.
+	SetText PatchOp = "SetText"
+)
+
+// Patch defines a single change to be applied to a DOM node which is selected
+// by the Target selector.
+type Patch struct {
+	Op     PatchOp `json:"Op"`
+	Target string  `json:"Target"`
+	Index  int     `json:"Index"`
+	Name   string  `json:"Name,omitempty"`
+	Value  string  `json:"Value,omitempty"`
+	Markup string  `json:"Markup,omitempty"`
+}
+
+// Diff returns the list of patches which turns the DOM rendered from the old
+// markup into the DOM of the new markup. The new markup is expected to have being
+// reconciled against the old markup (see Markup.Reconcile), so matching nodes share
+// the same uid and unchanged nodes share the same hash. If the old markup is nil
+// or does not match the new markup, then a single ReplaceNode patch is returned.
+// Patches target nodes using their uid, so the old markup must have being rendered
+// using the Normal mode.
+func Diff(old, next *Markup) []Patch {
+	var patches []Patch
+
+	if old == nil || old.UID() != next.UID() || old.Name() != next.Name() {
+		return append(patches, Patch{
+			Op:     ReplaceNode,
+			Target: uidSelector(next),
+			Index:  -1,
+			Markup: next.HTML(),
+		})
+	}
+
+	return diffMarkup(old, next, patches)
+}
+
+// diffMarkup adds the patches of the changes between the old and new markup into
+// the provided slice.
+func diffMarkup(old, next *Markup, patches []Patch) []Patch {
+	if old.Hash() == next.Hash() {
+		return patches
+	}
+
+	target := uidSelector(next)
+
+	patches = append(patches, Patch{Op: SetAttribute, Target: target, Index: -1, Name: "hash", Value: next.Hash()})
+	patches = diffProperties(target, old.Attributes(), next.Attributes(), SetAttribute, RemoveAttribute, patches)
+	patches = diffProperties(target, old.Styles(), next.Styles(), SetStyle, RemoveStyle, patches)
+
+	childPatches, ok := diffChildren(old, next)
+	if !ok || (old.TextContent() != next.TextContent() && len(next.activeChildren()) != 0) {
+		return append(patches, Patch{Op: ReplaceNode, Target: target, Index: -1, Markup: next.HTML()})
+	}
+
+	if old.TextContent() != next.TextContent() {
+		patches = append(patches, Patch{Op: SetText, Target: target, Index: -1, Value: next.TextContent()})
+	}
+
+	return append(patches, childPatches...)
+}
+
+// diffChildren returns the patches of the changes between the children of the
+// old and new markup. It returns false if the changes require moving, inserting
+// or removing text nodes, which are not addressable, or changing raw html, and
+// the markup must be replaced as a whole.
+func diffChildren(old, next *Markup) ([]Patch, bool) {
+	var patches []Patch
+
+	target := uidSelector(next)
+	newChildren := next.activeChildren()
+
+	// Reconciliation marks the old children missing from the new markup as
+	// removed, so all old children are considered.
+	oldChildren := old.Children()
+
+	// Elements with text content have that text written before their children.
+	var offset int
+	if next.TextContent() != "" {
+		offset = 1
+	}
+
+	newUIDs := make(map[string]bool)
+	for _, child := range newChildren {
+		newUIDs[child.UID()] = true
+	}
+
+	var current []*Markup
+	oldUIDs := make(map[string]*Markup)
+
+	for _, child := range oldChildren {
+		if newUIDs[child.UID()] {
+			oldUIDs[child.UID()] = child
+			current = append(current, child)
+			continue
+		}
+
+		if child.Name() == "text" {
+			return nil, false
+		}
+
+		patches = append(patches, Patch{Op: RemoveNode, Target: uidSelector(child), Index: -1})
+	}
+
+	var children []Patch
+
+	for index, child := range newChildren {
+		och, ok := oldUIDs[child.UID()]
+		if !ok {
+			if child.Name() == "text" {
+				return nil, false
+			}
+
+			patches = append(patches, Patch{Op: InsertNode, Target: target, Index: index + offset, Markup: child.HTML()})
+			current = insertMarkup(current, index, child)
+			continue
+		}
+
+		if current[index] != och {
+			if child.Name() == "text" {
+				return nil, false
+			}
+
+			patches = append(patches, Patch{Op: MoveNode, Target: uidSelector(child), Index: index + offset})
+			current = insertMarkup(removeMarkup(current, och), index, och)
+		}
+
+		if child.Name() == "text" {
+			if child.TextContent() != och.TextContent() {
+				// Raw html can not be set as text, so the markup is replaced.
+				if child.RawHTML() {
+					return nil, false
+				}
+
+				children = append(children, Patch{Op: SetText, Target: target, Index: index + offset, Value: child.TextContent()})
+			}
+
+			continue
+		}
+
+		children = diffMarkup(och, child, children)
+	}
+
+	return append(patches, children...), true
+}
+
+// diffProperties adds the patches of the changes between the old and new properties
+// using the provided operations. Attribute values are compared as sanitized, as
+// they are patched.
+func diffProperties(target string, old, next []Property, set, remove PatchOp, patches []Patch) []Patch {
+	oldValues := make(map[string]string)
+	for _, prop := range old {
+		name, value := prop.Render()
+
+		if set == SetAttribute {
+			value = SafeAttr(name, value)
+		}
+
+		oldValues[name] = value
+	}
+
+	newValues := make(map[string]bool)
+	for _, prop := range next {
+		name, value := prop.Render()
+		newValues[name] = true
+
+		if name == "NodeRemoved" {
+			continue
+		}
+
+		if set == SetAttribute {
+			value = SafeAttr(name, value)
+		}
+
+		if oldValue, ok := oldValues[name]; ok && oldValue == value {
+			continue
+		}
+
+		patches = append(patches, Patch{Op: set, Target: target, Index: -1, Name: name, Value: value})
+	}
+
+	for _, prop := range old {
+		name, _ := prop.Render()
+		if newValues[name] || name == "NodeRemoved" {
+			continue
+		}
+
+		patches = append(patches, Patch{Op: remove, Target: target, Index: -1, Name: name})
+	}
+
+	return patches
+}
+
+// activeChildren returns the children of the markup which are not marked removed.
+func (e *Markup) activeChildren() []*Markup {
+	var active []*Markup
+
+	for _, child := range e.children {
+		if !child.Removed() {
+			active = append(active, child)
+		}
+	}
+
+	return active
+}
+
+// uidSelector returns the selector matching the markup using its uid.
+func uidSelector(e *Markup) string {
+	return e.Name() + "[uid='" + e.UID() + "']"
+}
+
+// insertMarkup inserts the markup into the list at the provided index.
+func insertMarkup(list []*Markup, index int, m *Markup) []*Markup {
+	list = append(list, nil)
+	copy(list[index+1:], list[index:])
+	list[index] = m
+	return list
+}
+
+// removeMarkup removes the markup from the list.
+func removeMarkup(list []*Markup, m *Markup) []*Markup {
+	for index, item := range list {
+		if item == m {
+			return append(list[:index], list[index+1:]...)
+		}
+	}
+
+	return list
+}
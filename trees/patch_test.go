@@ -0,0 +1,94 @@
+package trees_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+func TestDiff(t *testing.T) {
+	old := makeList("a", "b", "c")
+	trees.NewAttr("class", "list").Apply(old)
+
+	updated := makeList("c", "a", "d")
+	trees.NewAttr("class", "list updated").Apply(updated)
+
+	updated.Reconcile(old)
+
+	patches := trees.Diff(old, updated)
+
+	expected := []trees.PatchOp{
+		trees.SetAttribute,
+		trees.SetAttribute,
+		trees.RemoveNode,
+		trees.MoveNode,
+		trees.InsertNode,
+	}
+
+	if len(patches) != len(expected) {
+		t.Fatalf("\t%s\t Should have generated %d patches: %#v", failed, len(expected), patches)
+	}
+	t.Logf("\t%s\t Should have generated %d patches", success, len(expected))
+
+	for index, op := range expected {
+		if patches[index].Op != op {
+			t.Fatalf("\t%s\t Should have generated %q patch at %d: %#v", failed, op, index, patches[index])
+		}
+	}
+	t.Logf("\t%s\t Should have generated patches in expected order", success)
+
+	if patches[1].Name != "class" || patches[1].Value != "list updated" {
+		t.Fatalf("\t%s\t Should have patched class attribute: %#v", failed, patches[1])
+	}
+	t.Logf("\t%s\t Should have patched class attribute", success)
+
+	if patches[3].Index != 0 || patches[3].Target != updated.Children()[0].EventID() {
+		t.Fatalf("\t%s\t Should have moved child with key %q to the front: %#v", failed, "c", patches[3])
+	}
+	t.Logf("\t%s\t Should have moved child with key %q to the front", success, "c")
+
+	if patches[4].Index != 2 || patches[4].Target != updated.EventID() {
+		t.Fatalf("\t%s\t Should have inserted child with key %q at the end: %#v", failed, "d", patches[4])
+	}
+	t.Logf("\t%s\t Should have inserted child with key %q at the end", success, "d")
+}
+
+func TestDiffText(t *testing.T) {
+	old := trees.NewMarkup("div", false)
+	trees.NewText("hello").Apply(old)
+
+	updated := trees.NewMarkup("div", false)
+	trees.NewText("world").Apply(updated)
+
+	updated.Reconcile(old)
+
+	patches := trees.Diff(old, updated)
+	last := patches[len(patches)-1]
+
+	if last.Op != trees.SetText || last.Index != 0 || last.Value != "world" {
+		t.Fatalf("\t%s\t Should have patched text of child: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have patched text of child", success)
+
+	if patches := trees.Diff(nil, updated); len(patches) != 1 || patches[0].Op != trees.ReplaceNode {
+		t.Fatalf("\t%s\t Should have replaced markup without old markup: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have replaced markup without old markup", success)
+}
+
+func TestDiffUnsafeAttributes(t *testing.T) {
+	old := trees.NewMarkup("a", false)
+	trees.NewAttr("href", "javascript:alert(1)").Apply(old)
+
+	updated := trees.NewMarkup("a", false)
+	trees.NewAttr("href", "javascript:alert(2)").Apply(updated)
+
+	updated.Reconcile(old)
+
+	for _, patch := range trees.Diff(old, updated) {
+		if patch.Name == "href" {
+			t.Fatalf("\t%s\t Should have not patched unchanged sanitized attribute: %#v", failed, patch)
+		}
+	}
+	t.Logf("\t%s\t Should have not patched unchanged sanitized attribute", success)
+}
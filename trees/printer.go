@@ -1,7 +1,11 @@
 package trees
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"html"
+	"io"
 	"strings"
 	"sync"
 )
@@ -62,7 +66,9 @@ type AttrWriter struct{}
 
 const attrformt = ` %s="%s"`
 
-// Print returns a stringed repesentation of the attribute object
+// Print returns a stringed repesentation of the attribute object. Attribute
+// values are html escaped, url attributes with unsafe schemes are replaced with
+// UnsafeURL and attributes with invalid names are skipped.
 func (m AttrWriter) Print(a []Property) string {
 	if len(a) <= 0 {
 		return ""
@@ -72,7 +78,11 @@ func (m AttrWriter) Print(a []Property) string {
 
 	for _, ar := range a {
 		name, val := ar.Render()
-		attrs = append(attrs, fmt.Sprintf(attrformt, name, val))
+		if !validAttrName(name) {
+			continue
+		}
+
+		attrs = append(attrs, fmt.Sprintf(attrformt, name, html.EscapeString(SafeAttr(name, val))))
 	}
 
 	return strings.Join(attrs, " ")
@@ -122,9 +132,19 @@ type TextWriter struct{}
 // SimpleTextWriter provides a basic text writer
 var SimpleTextWriter TextWriter
 
-// Print returns the string representation of the text object
+// Print returns the string representation of the text object. The text is
+// html escaped unless it's raw html or the content of a script or style element,
+// where only end tags are escaped.
 func (m TextWriter) Print(t *Markup) string {
-	return t.TextContent()
+	if t.RawHTML() {
+		return t.TextContent()
+	}
+
+	if t.parent != nil && rawTextElements[t.parent.Name()] {
+		return escapeRawText(t.TextContent())
+	}
+
+	return html.EscapeString(t.TextContent())
 }
 
 //==============================================================================
@@ -155,64 +175,247 @@ func (m *ElementWriter) Write(ma *Markup) (string, error) {
 
 // Print returns the string representation of the element
 func (m *ElementWriter) Print(e *Markup) string {
-	if e.Removed() && GetMode() > Normal {
-		return ""
+	buf := bufferPool.Get().(*bytes.Buffer)
+	defer bufferPool.Put(buf)
+
+	buf.Reset()
+	m.write(buf, e, GetMode())
+
+	return buf.String()
+}
+
+// WriteTo writes the html representation of the element into the writer as
+// it walks the element, without building the complete html in memory. It
+// returns the total bytes written and any error which occured.
+func (m *ElementWriter) WriteTo(w io.Writer, e *Markup) (int64, error) {
+	counter := &countWriter{w: w}
+
+	bw := writerPool.Get().(*bufio.Writer)
+	defer writerPool.Put(bw)
+
+	bw.Reset(counter)
+	m.write(bw, e, GetMode())
+
+	err := bw.Flush()
+	bw.Reset(nil)
+
+	return counter.n, err
+}
+
+// stringWriter defines a writer which writes strings directly.
+type stringWriter interface {
+	WriteString(string) (int, error)
+}
+
+// write writes out the element into the writer. Errors are not checked here, as
+// both the bytes.Buffer and bufio.Writer used keep them till they are flushed.
+func (m *ElementWriter) write(w stringWriter, e *Markup, mode Mode) {
+	if e.Removed() && mode > Normal {
+		return
 	}
 
-	//if we are dealing with a text type just return the content
+	//if we are dealing with a text type just write the content
 	if e.Name() == "text" {
-		return m.text.Print(e)
+		w.WriteString(m.text.Print(e))
+		return
 	}
 
-	// Management attributes.
-	var mido []Property
-
-	// Collect uid and hash of the element so we can write them along.
-	if GetMode() < Pretty {
-		hash := &Attribute{Name: "hash", Value: e.Hash()}
-		uid := &Attribute{Name: "uid", Value: e.UID()}
-		mido = append(mido, hash, uid)
+	w.WriteString("<")
+	w.WriteString(e.Name())
+
+	// The default AttrWriter is written directly to avoid building strings.
+	_, simpleAttrs := m.attrWriter.(AttrWriter)
+
+	// Write the uid and hash of the element as attributes.
+	if mode < Pretty {
+		if simpleAttrs {
+			writeAttr(w, "hash", e.Hash())
+			w.WriteString(" ")
+			writeAttr(w, "uid", e.UID())
+		} else {
+			w.WriteString(m.attrWriter.Print([]Property{
+				&Attribute{Name: "hash", Value: e.Hash()},
+				&Attribute{Name: "uid", Value: e.UID()},
+			}))
+		}
 	}
 
-	//write out the hash and uid as attributes
-	hashes := m.attrWriter.Print(mido)
-
 	//write out the elements attributes using the AttrWriter
-	attrs := m.attrWriter.Print(e.Attributes())
+	if simpleAttrs {
+		writeAttrs(w, e.Attributes())
+	} else {
+		w.WriteString(m.attrWriter.Print(e.Attributes()))
+	}
 
 	//write out the elements inline-styles using the StyleWriter
-	style := m.styleWriter.Print(e.Styles())
+	w.WriteString(` style="`)
+	w.WriteString(html.EscapeString(m.styleWriter.Print(e.Styles())))
+	w.WriteString(`"`)
+
+	// Only void elements can be self closing in html5, other auto closed
+	// elements (e.g svg elements) are written with an end tag.
+	if e.AutoClosed() && IsVoidElement(e.Name()) {
+		w.WriteString("/>")
+		return
+	}
 
-	var closer string
-	var beginbrack string
+	w.WriteString(">")
 
-	if e.AutoClosed() {
-		closer = "/>"
+	if rawTextElements[e.Name()] {
+		w.WriteString(escapeRawText(e.TextContent()))
 	} else {
-		beginbrack = ">"
-		closer = fmt.Sprintf("</%s>", e.Name())
+		w.WriteString(html.EscapeString(e.TextContent()))
 	}
 
-	var children = []string{}
 	for _, ch := range e.Children() {
 		if ch.UID() == e.UID() {
 			continue
 		}
 
-		children = append(children, m.Print(ch))
+		m.write(w, ch, mode)
+	}
+
+	w.WriteString("</")
+	w.WriteString(e.Name())
+	w.WriteString(">")
+}
+
+// writeAttrs writes the attributes into the writer, matching the output of the
+// AttrWriter.Print.
+func writeAttrs(w stringWriter, attrs []Property) {
+	var written bool
+
+	for _, ar := range attrs {
+		name, val := ar.Render()
+		if !validAttrName(name) {
+			continue
+		}
+
+		if written {
+			w.WriteString(" ")
+		}
+
+		writeAttr(w, name, val)
+		written = true
 	}
+}
+
+// writeAttr writes a single attribute into the writer in the attrformt format.
+func writeAttr(w stringWriter, name string, value string) {
+	w.WriteString(" ")
+	w.WriteString(name)
+	w.WriteString(`="`)
+	w.WriteString(html.EscapeString(SafeAttr(name, value)))
+	w.WriteString(`"`)
+}
+
+// bufferPool provides the buffers used by the ElementWriter.Print.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// writerPool provides the buffered writers used by the ElementWriter.WriteTo.
+var writerPool = sync.Pool{
+	New: func() interface{} {
+		return bufio.NewWriterSize(nil, 4096)
+	},
+}
+
+// countWriter wraps a writer counting the total bytes written.
+type countWriter struct {
+	w io.Writer
+	n int64
+}
 
-	//lets create the elements markup now
-	return strings.Join([]string{
-		fmt.Sprintf("<%s", e.Name()),
-		hashes,
-		attrs,
-		fmt.Sprintf(` style=%q`, style),
-		beginbrack,
-		e.TextContent(),
-		strings.Join(children, ""),
-		closer,
-	}, "")
+// Write writes the data into the underline writer.
+func (c *countWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
 }
 
 //==============================================================================
+
+// rawTextElements defines the elements whose text content are not escaped.
+var rawTextElements = map[string]bool{
+	"script": true,
+	"style":  true,
+}
+
+// escapeRawText returns the content of a script or style element with the "</"
+// of end tags escaped as "<\/", so the content can not close the element.
+func escapeRawText(text string) string {
+	return strings.Replace(text, "</", `<\/`, -1)
+}
+
+// urlAttrs defines the attributes whose values are urls.
+var urlAttrs = map[string]bool{
+	"href":       true,
+	"src":        true,
+	"action":     true,
+	"formaction": true,
+	"poster":     true,
+	"cite":       true,
+	"background": true,
+	"xlink:href": true,
+}
+
+// safeSchemes defines the url schemes allowed in url attributes.
+var safeSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+	"tel":    true,
+	"ftp":    true,
+}
+
+// UnsafeURL defines the value written in place of url attributes with unsafe
+// schemes, it matches the value used by the html/template package.
+const UnsafeURL = "#ZgotmplZ"
+
+// SafeAttr returns the value of the attribute, replacing values of url attributes
+// (e.g href, src) which are not safe according to SafeURL with UnsafeURL.
+func SafeAttr(name string, value string) string {
+	if !urlAttrs[strings.ToLower(name)] || SafeURL(value) {
+		return value
+	}
+
+	return UnsafeURL
+}
+
+// SafeURL returns true/false if the giving url is relative or uses a safe scheme
+// (http, https, mailto, tel, ftp). Data urls are only allowed for images.
+func SafeURL(url string) bool {
+	// Browsers ignore whitespace and control characters within schemes, so
+	// "java\tscript:" must be treated as "javascript:".
+	url = strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+
+		return r
+	}, url)
+
+	index := strings.IndexAny(url, ":/?#")
+	if index <= 0 || url[index] != ':' {
+		return true
+	}
+
+	scheme := strings.ToLower(url[:index])
+	if scheme == "data" {
+		return strings.HasPrefix(strings.ToLower(url[index+1:]), "image/")
+	}
+
+	return safeSchemes[scheme]
+}
+
+// validAttrName returns true/false if the name is a valid attribute name.
+func validAttrName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	return !strings.ContainsAny(name, " \t\n\f\r\"'<>/=")
+}
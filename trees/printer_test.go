@@ -0,0 +1,217 @@
+package trees_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+func TestElementWriterEscaping(t *testing.T) {
+	trees.SetMode(trees.Pretty)
+	defer trees.SetMode(trees.Normal)
+
+	div := trees.NewMarkup("div", false)
+	trees.NewAttr("title", `"><script>alert(1)</script>`).Apply(div)
+	trees.NewText("<b>bold</b> & more").Apply(div)
+	trees.NewRawHTML("&nbsp;<i>trusted</i>").Apply(div)
+
+	script := trees.NewMarkup("script", false)
+	trees.NewText("if (a < b && c) {}").Apply(script)
+	script.Apply(div)
+
+	html := div.HTML()
+
+	if strings.Contains(html, "<script>alert") || !strings.Contains(html, `title="&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;"`) {
+		t.Fatalf("\t%s\t Should have escaped attribute value: %q", failed, html)
+	}
+	t.Logf("\t%s\t Should have escaped attribute value", success)
+
+	if !strings.Contains(html, "&lt;b&gt;bold&lt;/b&gt; &amp; more") {
+		t.Fatalf("\t%s\t Should have escaped text content: %q", failed, html)
+	}
+	t.Logf("\t%s\t Should have escaped text content", success)
+
+	if !strings.Contains(html, "&nbsp;<i>trusted</i>") {
+		t.Fatalf("\t%s\t Should have written raw html as is: %q", failed, html)
+	}
+	t.Logf("\t%s\t Should have written raw html as is", success)
+
+	if !strings.Contains(html, "if (a < b && c) {}") {
+		t.Fatalf("\t%s\t Should have written script content as is: %q", failed, html)
+	}
+	t.Logf("\t%s\t Should have written script content as is", success)
+
+	breakout := trees.NewMarkup("script", false)
+	trees.NewText(`var s = "</script><script>alert(1)</script>";`).Apply(breakout)
+
+	style := trees.NewMarkup("style", false)
+	trees.NewText(`a { content: "</STYLE><img>" }`).Apply(style)
+
+	for _, elem := range []*trees.Markup{breakout, style} {
+		var buf bytes.Buffer
+		if _, err := trees.SimpleElementWriter.WriteTo(&buf, elem); err != nil {
+			t.Fatalf("\t%s\t Should have written %s element: %q", failed, elem.Name(), err)
+		}
+
+		for _, html := range []string{elem.HTML(), buf.String()} {
+			inner := strings.TrimSuffix(html, "</"+elem.Name()+">")
+			if strings.Contains(inner, "</") || !strings.Contains(inner, `<\/`) {
+				t.Fatalf("\t%s\t Should have escaped end tags in %s content: %q", failed, elem.Name(), html)
+			}
+		}
+	}
+	t.Logf("\t%s\t Should have escaped end tags in script and style content", success)
+}
+
+func TestElementWriterURLs(t *testing.T) {
+	trees.SetMode(trees.Pretty)
+	defer trees.SetMode(trees.Normal)
+
+	urls := map[string]string{
+		"https://github.com/gu-io/gu": "https://github.com/gu-io/gu",
+		"./assets/gu.png":             "./assets/gu.png",
+		"/users?id=1:2":               "/users?id=1:2",
+		"mailto:gu@gu.io":             "mailto:gu@gu.io",
+		"javascript:alert(1)":         trees.UnsafeURL,
+		" JavaScript:alert(1)":        trees.UnsafeURL,
+		"java\tscript:alert(1)":       trees.UnsafeURL,
+		"data:text/html,<b>":          trees.UnsafeURL,
+		"data:image/png;base64,AAAA":  "data:image/png;base64,AAAA",
+	}
+
+	for url, expected := range urls {
+		link := trees.NewMarkup("a", false)
+		trees.NewAttr("href", url).Apply(link)
+
+		if html := link.HTML(); !strings.Contains(html, `href="`+expected+`"`) {
+			t.Fatalf("\t%s\t Should have written %q as %q: %q", failed, url, expected, html)
+		}
+	}
+	t.Logf("\t%s\t Should have sanitised url attributes", success)
+}
+
+func makePage(sections int) *trees.Markup {
+	page := trees.NewMarkup("div", false)
+	trees.NewAttr("class", "page").Apply(page)
+
+	for i := 0; i < sections; i++ {
+		section := trees.NewMarkup("section", false)
+		trees.NewAttr("class", "section").Apply(section)
+		trees.NewCSSStyle("width", "100%").Apply(section)
+		trees.NewText("Section & title").Apply(section)
+
+		list := makeList("a", "b", "c", "d", "e")
+		list.Apply(section)
+		section.Apply(page)
+	}
+
+	return page
+}
+
+func TestElementWriterWriteTo(t *testing.T) {
+	page := makePage(10)
+
+	var buf bytes.Buffer
+	n, err := trees.SimpleElementWriter.WriteTo(&buf, page)
+	if err != nil {
+		t.Fatalf("\t%s\t Should have written markup: %q", failed, err.Error())
+	}
+	t.Logf("\t%s\t Should have written markup", success)
+
+	if html := page.HTML(); buf.String() != html || n != int64(len(html)) {
+		t.Fatalf("\t%s\t Should have written same html as Print: %q", failed, buf.String())
+	}
+	t.Logf("\t%s\t Should have written same html as Print", success)
+}
+
+// printLegacy returns the html of the markup as the ElementWriter did before it
+// wrote markup directly into writers, by joining the strings of each element.
+func printLegacy(e *trees.Markup) string {
+	if e.Removed() && trees.GetMode() > trees.Normal {
+		return ""
+	}
+
+	if e.Name() == "text" {
+		return trees.SimpleTextWriter.Print(e)
+	}
+
+	var mido []trees.Property
+
+	if trees.GetMode() < trees.Pretty {
+		hash := &trees.Attribute{Name: "hash", Value: e.Hash()}
+		uid := &trees.Attribute{Name: "uid", Value: e.UID()}
+		mido = append(mido, hash, uid)
+	}
+
+	hashes := trees.SimpleAttrWriter.Print(mido)
+	attrs := trees.SimpleAttrWriter.Print(e.Attributes())
+	style := trees.SimpleStyleWriter.Print(e.Styles())
+
+	var closer string
+	var beginbrack string
+
+	if e.AutoClosed() {
+		closer = "/>"
+	} else {
+		beginbrack = ">"
+		closer = fmt.Sprintf("</%s>", e.Name())
+	}
+
+	var children = []string{}
+	for _, ch := range e.Children() {
+		if ch.UID() == e.UID() {
+			continue
+		}
+
+		children = append(children, printLegacy(ch))
+	}
+
+	return strings.Join([]string{
+		fmt.Sprintf("<%s", e.Name()),
+		hashes,
+		attrs,
+		fmt.Sprintf(` style=%q`, style),
+		beginbrack,
+		e.TextContent(),
+		strings.Join(children, ""),
+		closer,
+	}, "")
+}
+
+func BenchmarkElementWriterPrintLegacy(b *testing.B) {
+	page := makePage(100)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		io.WriteString(ioutil.Discard, printLegacy(page))
+	}
+}
+
+func BenchmarkElementWriterPrint(b *testing.B) {
+	page := makePage(100)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		io.WriteString(ioutil.Discard, trees.SimpleElementWriter.Print(page))
+	}
+}
+
+func BenchmarkElementWriterWriteTo(b *testing.B) {
+	page := makePage(100)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		trees.SimpleElementWriter.WriteTo(ioutil.Discard, page)
+	}
+}
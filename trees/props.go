@@ -52,6 +52,17 @@ func When(state bool, first, other *Markup) *Markup {
 
 //==============================================================================
 
+// Key defines a Appliable which sets the reconciliation key of a markup, it
+// should be unique amongst the siblings of the markup.
+type Key string
+
+// Apply sets the key of the giving markup.
+func (k Key) Apply(e *Markup) {
+	e.SetKey(string(k))
+}
+
+//==============================================================================
+
 // Attribute define the struct  for attributes
 type Attribute struct {
 	Name  string
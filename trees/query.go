@@ -89,6 +89,17 @@ func (q queryCtrl) QueryAll(root *Markup, sel string) []*Markup {
 	return q.QueryAllSelector(root, sels[0])
 }
 
+// Match returns true/false if the giving element itself matches the selector.
+// Selectors for children (e.g "div span") never match.
+func (q queryCtrl) Match(target *Markup, sel string) bool {
+	sels := q.ParseSelector(sel)
+	if sels == nil || sels[0].Children != nil {
+		return false
+	}
+
+	return q.queryOne(target, sels[0])
+}
+
 // QuerySelector uses the provided selector and root returning the first
 // element that matches the selector's criteria.
 func (q queryCtrl) QuerySelector(root *Markup, sel *Selector) *Markup {
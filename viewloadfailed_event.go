@@ -0,0 +1,122 @@
+package gu
+
+import "sync"
+
+// ViewLoadFailedSubscriber defines a interface that which is used to subscribe specifically for
+// events  ViewLoadFailed type.
+type ViewLoadFailedSubscriber interface {
+	Receive(ViewLoadFailed)
+}
+
+//=========================================================================================================
+
+// ViewLoadFailedHandler defines a structure type which implements the
+// ViewLoadFailedSubscriber interface and the EventDistributor interface.
+type ViewLoadFailedHandler struct {
+	handle func(ViewLoadFailed)
+}
+
+// NewViewLoadFailedHandler returns a new instance of a ViewLoadFailedHandler.
+func NewViewLoadFailedHandler(fn func(ViewLoadFailed)) *ViewLoadFailedHandler {
+	return &ViewLoadFailedHandler{
+		handle: fn,
+	}
+}
+
+// Receive takes the giving value and execute it against the underline handler.
+func (sn *ViewLoadFailedHandler) Receive(elem ViewLoadFailed) {
+	sn.handle(elem)
+}
+
+// Handle takes the giving value and asserts the expected value to match the
+// ViewLoadFailed type then passes it to the Receive method.
+func (sn *ViewLoadFailedHandler) Handle(receive interface{}) {
+	if elem, ok := receive.(ViewLoadFailed); ok {
+		sn.Receive(elem)
+	}
+}
+
+//=========================================================================================================
+
+// ViewLoadFailedNotification defines a structure type which must be used to
+// receive ViewLoadFailed type has a event.
+type ViewLoadFailedNotification struct {
+	sml        sync.Mutex
+	subs       []ViewLoadFailedSubscriber
+	validation func(ViewLoadFailed) bool
+	register   map[ViewLoadFailedSubscriber]int
+}
+
+// NewViewLoadFailedNotificationWith returns a new instance of ViewLoadFailedNotification.
+func NewViewLoadFailedNotificationWith(validation func(ViewLoadFailed) bool) *ViewLoadFailedNotification {
+	var elem ViewLoadFailedNotification
+
+	elem.validation = validation
+	elem.register = make(map[ViewLoadFailedSubscriber]int, 0)
+
+	return &elem
+}
+
+// NewViewLoadFailedNotification returns a new instance of NewViewLoadFailedNotification.
+func NewViewLoadFailedNotification() *ViewLoadFailedNotification {
+	var elem ViewLoadFailedNotification
+	elem.register = make(map[ViewLoadFailedSubscriber]int, 0)
+
+	return &elem
+}
+
+// UnNotify removes the given subscriber from the notification's list if found from future events.
+func (sn *ViewLoadFailedNotification) UnNotify(sub ViewLoadFailedSubscriber) {
+	sn.do(func() {
+		index, ok := sn.register[sub]
+		if !ok {
+			return
+		}
+
+		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+	})
+}
+
+// Notify adds the given subscriber into the notification list and will await an update of
+// a new event of the given ViewLoadFailed type.
+func (sn *ViewLoadFailedNotification) Notify(sub ViewLoadFailedSubscriber) {
+	sn.do(func() {
+		sn.register[sub] = len(sn.subs)
+		sn.subs = append(sn.subs, sub)
+	})
+}
+
+// Handle takes the giving value and asserts the expected value to be of
+// the type and pass on to it's underline subscribers else ignoring the event.
+func (sn *ViewLoadFailedNotification) Handle(elem interface{}) {
+	if elemEvent, ok := elem.(ViewLoadFailed); ok {
+		if sn.validation != nil && sn.validation(elemEvent) {
+			sn.do(func() {
+				for _, sub := range sn.subs {
+					sub.Receive(elemEvent)
+				}
+			})
+
+			return
+		}
+
+		sn.do(func() {
+			for _, sub := range sn.subs {
+				sub.Receive(elemEvent)
+			}
+		})
+	}
+}
+
+// do performs action with the mutex locked and unlocked appropriately, ensuring safe
+// concurrent access.
+func (sn *ViewLoadFailedNotification) do(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	sn.sml.Lock()
+	defer sn.sml.Unlock()
+
+	fn()
+}